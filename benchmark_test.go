@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// syntheticRequest returns a request generating a file declaring many services, each with many methods of every kind.
+func syntheticRequest(services int, methods int, parameter string) *pluginpb.CodeGeneratorRequest {
+	field := func(name string, number int32, kind descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     kind.Enum(),
+			Label:    label.Enum(),
+		}
+	}
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("synthetic/synthetic.proto"),
+		Package: proto.String("synthetic"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String(goPackagePrefix + "/synthetic")},
+	}
+
+	for s := range services {
+		service := &descriptorpb.ServiceDescriptorProto{Name: proto.String(fmt.Sprintf("Synthetic%dAPI", s))}
+		for m := range methods {
+			request := fmt.Sprintf("Synthetic%d_%dRequest", s, m)
+			response := fmt.Sprintf("Synthetic%d_%dResponse", s, m)
+			file.MessageType = append(file.MessageType,
+				&descriptorpb.DescriptorProto{Name: proto.String(request), Field: []*descriptorpb.FieldDescriptorProto{
+					field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+					field("count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+					field("tags", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+				}},
+				&descriptorpb.DescriptorProto{Name: proto.String(response), Field: []*descriptorpb.FieldDescriptorProto{
+					field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL),
+				}},
+			)
+
+			// methods cycle through unary, server, client & bidi streaming.
+			service.Method = append(service.Method, &descriptorpb.MethodDescriptorProto{
+				Name:            proto.String(fmt.Sprintf("Method%d", m)),
+				InputType:       proto.String(".synthetic." + request),
+				OutputType:      proto.String(".synthetic." + response),
+				ServerStreaming: proto.Bool(m%4 == 1 || m%4 == 3),
+				ClientStreaming: proto.Bool(m%4 == 2 || m%4 == 3),
+			})
+		}
+		file.Service = append(file.Service, service)
+	}
+
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		Parameter:      proto.String(parameter),
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{file},
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	benchmarks := []struct {
+		services int
+		methods  int
+	}{
+		{services: 1, methods: 10},
+		{services: 10, methods: 10},
+		{services: 20, methods: 20},
+	}
+
+	const parameter = "server=true,tests=true,builders=true,validation=true,domain=true,importPath=github.com/lcmaguire/protoc-gen-go-boilerplate/example"
+	for _, bm := range benchmarks {
+		b.Run(fmt.Sprintf("%d services %d methods", bm.services, bm.methods), func(b *testing.B) {
+			req := syntheticRequest(bm.services, bm.methods, parameter)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				opts, f := plugin()
+				resp, err := generate(opts, f, req)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if resp.Error != nil {
+					b.Fatal(resp.GetError())
+				}
+			}
+		})
+	}
+}
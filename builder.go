@@ -0,0 +1,45 @@
+package main
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Builders data used to generate fluent builders for messages.
+type Builders struct {
+	// Messages the messages to generate builders for.
+	Messages []*protogen.Message
+}
+
+// builderMessages appends the input & output messages of the methods to messages, along with every message
+// reachable via their fields. only messages declared within the go package importPath are appended,
+// messages which have previously been appended are skipped.
+func builderMessages(messages []*protogen.Message, methods []Method, importPath protogen.GoImportPath) []*protogen.Message {
+	seen := map[protoreflect.FullName]bool{}
+	for _, message := range messages {
+		seen[message.Desc.FullName()] = true
+	}
+
+	var visit func(message *protogen.Message)
+	visit = func(message *protogen.Message) {
+		if message == nil || seen[message.Desc.FullName()] || message.GoIdent.GoImportPath != importPath {
+			return
+		}
+		seen[message.Desc.FullName()] = true
+
+		// map entries are set via their map field rather than a builder.
+		if !message.Desc.IsMapEntry() {
+			messages = append(messages, message)
+		}
+
+		for _, field := range message.Fields {
+			visit(field.Message)
+		}
+	}
+
+	for _, method := range methods {
+		visit(method.Method.Input)
+		visit(method.Method.Output)
+	}
+	return messages
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildersPresence(t *testing.T) {
+	// fields with explicit presence are generated as pointers, other than messages, bytes, lists & maps.
+	tests := map[string][]string{
+		"presence/proto2api/zz_generated_builders.go": {
+			"b.msg.Name = &v",
+			"b.msg.Count = &v",
+			"b.msg.Data = v",
+			"b.msg.Nested = v",
+			"b.msg.Tags = v",
+			"b.msg.Counts = v",
+			"b.msg.Ok = &v",
+		},
+		"presence/editionsapi/zz_generated_builders.go": {
+			"b.msg.Name = &v",
+			"b.msg.Count = v",
+			"b.msg.Data = v",
+		},
+	}
+
+	for _, templateDirectory := range []string{"templates", "templates/connect"} {
+		generated := generateFiles(t, compileRequest(t, "testdata/proto", "builders=true,paths=source_relative,templateDirectory="+templateDirectory))
+		for fileName, statements := range tests {
+			builders, ok := generated[fileName]
+			if !ok {
+				t.Fatalf("%s: %s was not generated", templateDirectory, fileName)
+			}
+			for _, statement := range statements {
+				if !strings.Contains(builders, statement) {
+					t.Errorf("%s: %s does not contain %q\n%s", templateDirectory, fileName, statement, builders)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"gopkg.in/yaml.v3"
+)
+
+// Config configuration of the plugin loaded from the file provided via the config option.
+//
+// options provided as plugin parameters take precedence over the config file.
+type Config struct {
+	// TemplateDirectory custom directory for templates.
+	TemplateDirectory string `yaml:"templateDirectory" json:"templateDirectory"`
+	// BaseTemplateDirectory embedded directory providing templates missing from TemplateDirectory.
+	BaseTemplateDirectory string `yaml:"baseTemplateDirectory" json:"baseTemplateDirectory"`
+	// ImportPath go import path of the output directory.
+	ImportPath string `yaml:"importPath" json:"importPath"`
+	// Templates custom templates used for every service.
+	Templates Templates `yaml:"templates" json:"templates"`
+	// MethodTemplates custom templates for methods matching a pattern e.g List*:templates/list.tmpl.
+	MethodTemplates []string `yaml:"methodTemplates" json:"methodTemplates"`
+	// Layout where & how generated code is output.
+	Layout Layout `yaml:"layout" json:"layout"`
+	// PostProcess post processors applied to generated files in order e.g gofmt.
+	PostProcess []string `yaml:"postProcess" json:"postProcess"`
+	// AllowImports imports which may be added when resolving imports hermetically e.g log/slog.
+	AllowImports []string `yaml:"allowImports" json:"allowImports"`
+	// Generate generators which are enabled.
+	Generate Generators `yaml:"generate" json:"generate"`
+	// Features feature toggles.
+	Features Features `yaml:"features" json:"features"`
+	// Services overrides keyed by the go name or full name of a service.
+	Services map[string]ServiceConfig `yaml:"services" json:"services"`
+}
+
+// Templates custom template files for each kind of generated file.
+type Templates struct {
+	Unary        string `yaml:"unary" json:"unary"`
+	ClientStream string `yaml:"clientStream" json:"clientStream"`
+	ServerStream string `yaml:"serverStream" json:"serverStream"`
+	BidiStream   string `yaml:"bidiStream" json:"bidiStream"`
+	Service      string `yaml:"service" json:"service"`
+	Server       string `yaml:"server" json:"server"`
+}
+
+// Layout where & how generated code is output.
+type Layout struct {
+	OutputRoot    string `yaml:"outputRoot" json:"outputRoot"`
+	PathPattern   string `yaml:"pathPattern" json:"pathPattern"`
+	GoPackageName string `yaml:"goPackageName" json:"goPackageName"`
+	StructName    string `yaml:"structName" json:"structName"`
+	SharedPackage bool   `yaml:"sharedPackage" json:"sharedPackage"`
+}
+
+// Generators generators which are enabled.
+type Generators struct {
+	Server     bool `yaml:"server" json:"server"`
+	Tests      bool `yaml:"tests" json:"tests"`
+	Builders   bool `yaml:"builders" json:"builders"`
+	Messages   bool `yaml:"messages" json:"messages"`
+	Validation bool `yaml:"validation" json:"validation"`
+	Validate   bool `yaml:"validate" json:"validate"`
+	Domain     bool `yaml:"domain" json:"domain"`
+}
+
+// Features feature toggles.
+type Features struct {
+	OnlyNew         bool `yaml:"onlyNew" json:"onlyNew"`
+	Merge           bool `yaml:"merge" json:"merge"`
+	Split           bool `yaml:"split" json:"split"`
+	HermeticImports bool `yaml:"hermeticImports" json:"hermeticImports"`
+}
+
+// ServiceConfig overrides for a single service.
+type ServiceConfig struct {
+	// Skip will not generate any code for the service.
+	Skip bool `yaml:"skip" json:"skip"`
+	// StructName template for the name of the struct implementing the service.
+	StructName string `yaml:"structName" json:"structName"`
+	// Templates custom templates used for the service.
+	Templates Templates `yaml:"templates" json:"templates"`
+	// Methods overrides keyed by the go name or proto name of a method.
+	Methods map[string]MethodConfig `yaml:"methods" json:"methods"`
+}
+
+// MethodConfig overrides for a single method.
+type MethodConfig struct {
+	// Skip will not generate any code for the method.
+	Skip bool `yaml:"skip" json:"skip"`
+	// Template custom template used for the method.
+	Template string `yaml:"template" json:"template"`
+}
+
+// loadConfig reads the config file, json files are decoded as json & all other files as yaml.
+//
+// unknown keys result in an error.
+func loadConfig(fileName string) (*Config, error) {
+	bites, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	cfg := &Config{}
+	if filepath.Ext(fileName) == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(bites))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(cfg)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(bites))
+		decoder.KnownFields(true)
+		err = decoder.Decode(cfg)
+		// an empty file is a valid config.
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", fileName, err)
+	}
+
+	return cfg, nil
+}
+
+// apply sets every flag which has not been provided as a plugin parameter to its value within the config.
+func (c *Config) apply(flags *flag.FlagSet) error {
+	provided := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) {
+		provided[f.Name] = true
+	})
+
+	values := map[string]string{
+		"templateDirectory":          c.TemplateDirectory,
+		"baseTemplateDirectory":      c.BaseTemplateDirectory,
+		"importPath":                 c.ImportPath,
+		"unaryMethodTemplate":        c.Templates.Unary,
+		"clientStreamMethodTemplate": c.Templates.ClientStream,
+		"serverStreamMethodTemplate": c.Templates.ServerStream,
+		"bidiStreamMethodTemplate":   c.Templates.BidiStream,
+		"serviceTemplate":            c.Templates.Service,
+		"serverTemplate":             c.Templates.Server,
+		"outputRoot":                 c.Layout.OutputRoot,
+		"pathPattern":                c.Layout.PathPattern,
+		"goPackageName":              c.Layout.GoPackageName,
+		"structName":                 c.Layout.StructName,
+	}
+	toggles := map[string]bool{
+		"sharedPackage":   c.Layout.SharedPackage,
+		"server":          c.Generate.Server,
+		"tests":           c.Generate.Tests,
+		"builders":        c.Generate.Builders,
+		"messages":        c.Generate.Messages,
+		"validation":      c.Generate.Validation,
+		"validate":        c.Generate.Validate,
+		"domain":          c.Generate.Domain,
+		"onlyNew":         c.Features.OnlyNew,
+		"merge":           c.Features.Merge,
+		"split":           c.Features.Split,
+		"hermeticImports": c.Features.HermeticImports,
+	}
+	for name, toggle := range toggles {
+		if toggle {
+			values[name] = strconv.FormatBool(toggle)
+		}
+	}
+
+	for name, value := range values {
+		if value == "" || provided[name] {
+			continue
+		}
+		if err := flags.Set(name, value); err != nil {
+			return fmt.Errorf("config: %s: %w", name, err)
+		}
+	}
+
+	// repeatable options are only populated when none have been provided as plugin parameters.
+	repeated := map[string][]string{
+		"methodTemplate": c.MethodTemplates,
+		"postProcess":    c.PostProcess,
+		"allowImport":    c.AllowImports,
+	}
+	for name, values := range repeated {
+		if provided[name] {
+			continue
+		}
+		for _, value := range values {
+			if err := flags.Set(name, value); err != nil {
+				return fmt.Errorf("config: %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validate ensures every method the config refers to belongs to its service.
+//
+// services which are not being generated are ignored, as a config may be shared by many runs of the plugin
+// e.g buf runs local plugins once per directory. services which are not declared by any file of the request are
+// warned of as they are likely misspelt.
+func (c *Config) validate(gen *protogen.Plugin) error {
+	keys := make([]string, 0, len(c.Services))
+	for key := range c.Services {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if !declaresService(gen, key) {
+			warnf("config: services.%s does not match any service of the request", key)
+		}
+
+		service := findService(gen, key)
+		if service == nil {
+			continue
+		}
+		serviceConfig := c.Services[key]
+		for methodKey := range serviceConfig.Methods {
+			if findMethod(service, methodKey) == nil {
+				return fmt.Errorf("config: services.%s.methods.%s does not match any method of %s", key, methodKey, service.Desc.FullName())
+			}
+		}
+	}
+	return nil
+}
+
+// service returns the overrides for the service.
+func (c *Config) service(service *protogen.Service) ServiceConfig {
+	if c == nil {
+		return ServiceConfig{}
+	}
+	if serviceConfig, ok := c.Services[string(service.Desc.FullName())]; ok {
+		return serviceConfig
+	}
+	return c.Services[service.GoName]
+}
+
+// method returns the overrides for the method.
+func (s ServiceConfig) method(method *protogen.Method) MethodConfig {
+	if methodConfig, ok := s.Methods[method.GoName]; ok {
+		return methodConfig
+	}
+	return s.Methods[string(method.Desc.Name())]
+}
+
+// findService returns the service being generated with the provided go name or full name.
+func findService(gen *protogen.Plugin, name string) *protogen.Service {
+	for _, file := range gen.Files {
+		if !file.Generate {
+			continue
+		}
+		for _, service := range file.Services {
+			if service.GoName == name || string(service.Desc.FullName()) == name {
+				return service
+			}
+		}
+	}
+	return nil
+}
+
+// declaresService reports whether any file of the request, including files which are not being generated, declares
+// a service with the provided go name or full name.
+func declaresService(gen *protogen.Plugin, name string) bool {
+	for _, file := range gen.Files {
+		for _, service := range file.Services {
+			if service.GoName == name || string(service.Desc.FullName()) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findMethod returns the method of the service with the provided go name or proto name.
+func findMethod(service *protogen.Service, name string) *protogen.Method {
+	for _, method := range service.Methods {
+		if method.GoName == name || string(method.Desc.Name()) == name {
+			return method
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestConfigValidate(t *testing.T) {
+	// buf runs local plugins once per directory, so only validated/validated.proto is being generated.
+	gen, err := protogen.Options{}.New(compileRequest(t, "proto", "", "validated/validated.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		services map[string]ServiceConfig
+		err      string
+		// warn the warning of a service key which matches no service of the request.
+		warn string
+	}{
+		"service & method being generated": {
+			services: map[string]ServiceConfig{"validated.BookAPI": {Methods: map[string]MethodConfig{"GetBook": {Skip: true}}}},
+		},
+		"service by go name": {
+			services: map[string]ServiceConfig{"BookAPI": {Methods: map[string]MethodConfig{"GetBook": {}}}},
+		},
+		"service of another directory": {
+			services: map[string]ServiceConfig{"proto.ExampleAPI": {Methods: map[string]MethodConfig{"ExampleRpc": {}}}},
+			warn:     "config: services.proto.ExampleAPI does not match any service of the request",
+		},
+		"misspelt service": {
+			services: map[string]ServiceConfig{"BookAPI": {}, "BooksAPI": {Skip: true}},
+			warn:     "config: services.BooksAPI does not match any service of the request",
+		},
+		"unknown method": {
+			services: map[string]ServiceConfig{"BookAPI": {Methods: map[string]MethodConfig{"DeleteBook": {}}}},
+			err:      "services.BookAPI.methods.DeleteBook does not match any method of validated.BookAPI",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var warned bytes.Buffer
+			warnings = &warned
+			t.Cleanup(func() { warnings = os.Stderr })
+
+			err := (&Config{Services: tt.services}).validate(gen)
+			if got := strings.TrimPrefix(strings.TrimSpace(warned.String()), "protoc-gen-go-boilerplate: warning: "); got != tt.warn {
+				t.Errorf("got warning %q, want %q", got, tt.warn)
+			}
+			if tt.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := map[string]struct {
+		fileName string
+		content  string
+		err      string
+	}{
+		"yaml": {
+			fileName: "boilerplate.yaml",
+			content:  "generate:\n  server: true\n  builders: true\n",
+		},
+		"json": {
+			fileName: "boilerplate.json",
+			content:  `{"generate": {"server": true, "builders": true}}`,
+		},
+		"empty": {
+			fileName: "boilerplate.yaml",
+		},
+		"unknown yaml key": {
+			fileName: "boilerplate.yaml",
+			content:  "generate:\n  mocks: true\n",
+			err:      "field mocks not found",
+		},
+		"unknown json key": {
+			fileName: "boilerplate.json",
+			content:  `{"generate": {"client": true}}`,
+			err:      `unknown field "client"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), tt.fileName)
+			if err := os.WriteFile(fileName, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := loadConfig(fileName)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	timestampFullName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationFullName  protoreflect.FullName = "google.protobuf.Duration"
+)
+
+var (
+	timePackage        = protogen.GoImportPath("time")
+	timestamppbPackage = protogen.GoImportPath("google.golang.org/protobuf/types/known/timestamppb")
+	durationpbPackage  = protogen.GoImportPath("google.golang.org/protobuf/types/known/durationpb")
+)
+
+// Domain data used to generate plain go domain structs mirroring messages, along with mappers to & from their messages.
+type Domain struct {
+	// Structs the domain structs of messages.
+	Structs []DomainStruct
+	// Enums the domain enums of the enums referred to by the structs.
+	Enums []DomainEnum
+}
+
+// DomainStruct a domain struct mirroring a message.
+type DomainStruct struct {
+	// Name of the domain struct e.g Example.
+	Name string
+	// ProtoType the message qualified by its package e.g foo.Example.
+	ProtoType string
+	Message   *protogen.Message
+	Fields    []DomainField
+}
+
+// DomainField a field of a domain struct.
+type DomainField struct {
+	// Name of the field e.g FooMap.
+	Name string
+	// Type the go type of the field e.g map[string]*Foo.
+	Type string
+	// FromProto go statements setting the field of `out` from the message `in`.
+	FromProto string
+	// ToProto go statements setting the message `out` from the field of `in`.
+	ToProto string
+	Field   *protogen.Field
+}
+
+// DomainEnum a domain enum mirroring an enum.
+type DomainEnum struct {
+	// Name of the domain enum e.g Data.
+	Name   string
+	Enum   *protogen.Enum
+	Values []DomainEnumValue
+}
+
+// DomainEnumValue a value of a domain enum.
+type DomainEnumValue struct {
+	// Name of the constant e.g Data_DATA_UNSPECIFIED.
+	Name   string
+	Number protoreflect.EnumNumber
+}
+
+// domainMapper generates the domain types & mapping statements of messages declared within a go package.
+//
+// types are qualified relative to f.
+type domainMapper struct {
+	f          *protogen.GeneratedFile
+	importPath protogen.GoImportPath
+}
+
+// newDomain creates the Domain data for the messages, enums of their fields declared within importPath are mirrored.
+func newDomain(f *protogen.GeneratedFile, messages []*protogen.Message, importPath protogen.GoImportPath) *Domain {
+	m := domainMapper{f: f, importPath: importPath}
+
+	domain := &Domain{}
+	seen := map[protoreflect.FullName]bool{}
+	for _, message := range messages {
+		s := DomainStruct{
+			Name:      message.GoIdent.GoName,
+			ProtoType: f.QualifiedGoIdent(message.GoIdent),
+			Message:   message,
+		}
+		for _, field := range message.Fields {
+			s.Fields = append(s.Fields, m.field(field))
+
+			enum := field.Enum
+			if field.Desc.IsMap() {
+				enum = field.Message.Fields[1].Enum
+			}
+			if enum == nil || !m.local(enum.GoIdent) || seen[enum.Desc.FullName()] {
+				continue
+			}
+			seen[enum.Desc.FullName()] = true
+
+			e := DomainEnum{Name: enum.GoIdent.GoName, Enum: enum}
+			for _, value := range enum.Values {
+				e.Values = append(e.Values, DomainEnumValue{Name: value.GoIdent.GoName, Number: value.Desc.Number()})
+			}
+			domain.Enums = append(domain.Enums, e)
+		}
+		domain.Structs = append(domain.Structs, s)
+	}
+	return domain
+}
+
+// checkDomainNames returns an error when a domain struct or enum would redeclare a struct implementing the services of
+// the package e.g a message named Service.
+func checkDomainNames(domain *Domain, structNames []string) error {
+	for _, s := range domain.Structs {
+		if slices.Contains(structNames, s.Name) {
+			return fmt.Errorf("domain: the domain struct of %s would redeclare the service struct %s, the structName option may be used to rename it", s.Message.Desc.FullName(), s.Name)
+		}
+	}
+	for _, e := range domain.Enums {
+		if slices.Contains(structNames, e.Name) {
+			return fmt.Errorf("domain: the domain enum of %s would redeclare the service struct %s, the structName option may be used to rename it", e.Enum.Desc.FullName(), e.Name)
+		}
+	}
+	return nil
+}
+
+// local reports whether the identifier is declared within the go package of the mirrored messages.
+func (m domainMapper) local(ident protogen.GoIdent) bool {
+	return ident.GoImportPath == m.importPath
+}
+
+// field returns the domain field of a message field.
+func (m domainMapper) field(field *protogen.Field) DomainField {
+	name := field.GoName
+	df := DomainField{Name: name, Field: field}
+
+	switch {
+	case field.Desc.IsMap():
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		df.Type = "map[" + goType(m.f, key) + "]" + m.valueType(value)
+		df.FromProto = "if in.Get" + name + "() != nil {\n" +
+			"out." + name + " = make(" + df.Type + ", len(in.Get" + name + "()))\n" +
+			"for k, v := range in.Get" + name + "() {\n" +
+			"out." + name + "[k] = " + m.fromProto(value, "v") + "\n" +
+			"}\n" +
+			"}"
+		df.ToProto = "if in." + name + " != nil {\n" +
+			"out." + name + " = make(map[" + goType(m.f, key) + "]" + goType(m.f, value) + ", len(in." + name + "))\n" +
+			"for k, v := range in." + name + " {\n" +
+			"out." + name + "[k] = " + m.toProto(value, "v") + "\n" +
+			"}\n" +
+			"}"
+	case field.Desc.IsList():
+		df.Type = "[]" + m.valueType(field)
+		// values which are not converted are copied in a single append.
+		if m.fromProto(field, "v") == "v" {
+			df.FromProto = "out." + name + " = append(out." + name + ", in.Get" + name + "()...)"
+			df.ToProto = "out." + name + " = append(" + "out." + name + ", in." + name + "...)"
+			break
+		}
+		df.FromProto = "for _, v := range in.Get" + name + "() {\n" +
+			"out." + name + " = append(out." + name + ", " + m.fromProto(field, "v") + ")\n" +
+			"}"
+		df.ToProto = "for _, v := range in." + name + " {\n" +
+			"out." + name + " = append(" + "out." + name + ", " + m.toProto(field, "v") + ")\n" +
+			"}"
+	case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
+		// oneof members are mirrored as optional fields, only one of which is expected to be set.
+		wrapper := m.f.QualifiedGoIdent(field.GoIdent)
+		df.Type = m.valueType(field)
+		if field.Message == nil || m.isTime(field) {
+			df.Type = "*" + df.Type
+			df.FromProto = "if x, ok := in.Get" + field.Oneof.GoName + "().(*" + wrapper + "); ok {\n" +
+				"v := " + m.fromProto(field, "x."+name) + "\n" +
+				"out." + name + " = &v\n" +
+				"}"
+			df.ToProto = "if in." + name + " != nil {\n" +
+				"out." + field.Oneof.GoName + " = &" + wrapper + "{" + name + ": " + m.toProto(field, "*in."+name) + "}\n" +
+				"}"
+			break
+		}
+		df.FromProto = "if x, ok := in.Get" + field.Oneof.GoName + "().(*" + wrapper + "); ok {\n" +
+			"out." + name + " = " + m.fromProto(field, "x."+name) + "\n" +
+			"}"
+		df.ToProto = "if in." + name + " != nil {\n" +
+			"out." + field.Oneof.GoName + " = &" + wrapper + "{" + name + ": " + m.toProto(field, "in."+name) + "}\n" +
+			"}"
+	case field.Desc.HasPresence() && field.Message == nil && field.Desc.Kind() != protoreflect.BytesKind:
+		df.Type = "*" + m.valueType(field)
+		df.FromProto = "if in." + name + " != nil {\n" +
+			"v := " + m.fromProto(field, "*in."+name) + "\n" +
+			"out." + name + " = &v\n" +
+			"}"
+		df.ToProto = "if in." + name + " != nil {\n" +
+			"v := " + m.toProto(field, "*in."+name) + "\n" +
+			"out." + name + " = &v\n" +
+			"}"
+	case m.isTime(field):
+		df.Type = m.valueType(field)
+		df.FromProto = "if in.Get" + name + "() != nil {\n" +
+			"out." + name + " = " + m.fromProto(field, "in.Get"+name+"()") + "\n" +
+			"}"
+		set := "!in." + name + ".IsZero()"
+		if field.Message.Desc.FullName() == durationFullName {
+			set = "in." + name + " != 0"
+		}
+		df.ToProto = "if " + set + " {\n" +
+			"out." + name + " = " + m.toProto(field, "in."+name) + "\n" +
+			"}"
+	default:
+		df.Type = m.valueType(field)
+		df.FromProto = "out." + name + " = " + m.fromProto(field, "in.Get"+name+"()")
+		df.ToProto = "out." + name + " = " + m.toProto(field, "in."+name)
+	}
+	return df
+}
+
+// isTime reports whether the field is a google.protobuf.Timestamp or google.protobuf.Duration.
+func (m domainMapper) isTime(field *protogen.Field) bool {
+	return field.Message != nil && (field.Message.Desc.FullName() == timestampFullName || field.Message.Desc.FullName() == durationFullName)
+}
+
+// valueType returns the domain type of a single value of the field.
+func (m domainMapper) valueType(field *protogen.Field) string {
+	switch {
+	case field.Enum != nil && m.local(field.Enum.GoIdent):
+		return field.Enum.GoIdent.GoName
+	case field.Message == nil:
+		return goType(m.f, field)
+	case field.Message.Desc.FullName() == timestampFullName:
+		return m.f.QualifiedGoIdent(timePackage.Ident("Time"))
+	case field.Message.Desc.FullName() == durationFullName:
+		return m.f.QualifiedGoIdent(timePackage.Ident("Duration"))
+	case m.local(field.Message.GoIdent):
+		return "*" + field.Message.GoIdent.GoName
+	}
+	return goType(m.f, field)
+}
+
+// fromProto returns a go expression mapping a single value of the message field to its domain type.
+func (m domainMapper) fromProto(field *protogen.Field, expr string) string {
+	switch {
+	case field.Enum != nil && m.local(field.Enum.GoIdent):
+		return field.Enum.GoIdent.GoName + "(" + expr + ")"
+	case field.Message == nil:
+		return expr
+	case field.Message.Desc.FullName() == timestampFullName:
+		return expr + ".AsTime()"
+	case field.Message.Desc.FullName() == durationFullName:
+		return expr + ".AsDuration()"
+	case m.local(field.Message.GoIdent):
+		return field.Message.GoIdent.GoName + "FromProto(" + expr + ")"
+	}
+	return expr
+}
+
+// toProto returns a go expression mapping a single domain value of the field to its message type.
+func (m domainMapper) toProto(field *protogen.Field, expr string) string {
+	switch {
+	case field.Enum != nil && m.local(field.Enum.GoIdent):
+		return m.f.QualifiedGoIdent(field.Enum.GoIdent) + "(" + expr + ")"
+	case field.Message == nil:
+		return expr
+	case field.Message.Desc.FullName() == timestampFullName:
+		return m.f.QualifiedGoIdent(timestamppbPackage.Ident("New")) + "(" + expr + ")"
+	case field.Message.Desc.FullName() == durationFullName:
+		return m.f.QualifiedGoIdent(durationpbPackage.Ident("New")) + "(" + expr + ")"
+	case m.local(field.Message.GoIdent):
+		return expr + ".ToProto()"
+	}
+	return expr
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDomainNameClashes(t *testing.T) {
+	tests := map[string]string{
+		"":                   "domain: the domain struct of names.Service would redeclare the service struct Service",
+		"structName=Shelf":   "domain: the domain struct of names.Shelf would redeclare the service struct Shelf",
+		"structName=Kind":    "domain: the domain enum of names.Kind would redeclare the service struct Kind",
+		"structName=Library": "",
+	}
+	for options, want := range tests {
+		opts, f := plugin()
+		resp, err := generate(opts, f, compileRequest(t, "testdata/domain", strings.TrimPrefix(options+",domain=true", ",")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want == "" {
+			if resp.Error != nil {
+				t.Errorf("%s: %s", options, resp.GetError())
+			}
+			continue
+		}
+		if !strings.Contains(resp.GetError(), want) {
+			t.Errorf("%s: got error %q, want %q", options, resp.GetError(), want)
+		}
+	}
+}
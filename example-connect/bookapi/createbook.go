@@ -0,0 +1,16 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+
+	"context"
+
+	connect "connectrpc.com/connect"
+)
+
+// CreateBook is a connect rpc implementation of validated.BookAPI.CreateBook.
+//
+// CreateBook validates its request with buf.validate constraints.
+func (s *Service) CreateBook(ctx context.Context, in *connect.Request[validated.CreateBookRequest]) (*connect.Response[validated.Book], error) {
+	return connect.NewResponse(&validated.Book{}), nil
+}
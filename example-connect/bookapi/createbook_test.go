@@ -0,0 +1,69 @@
+package validated
+
+import (
+	"context"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	connect "connectrpc.com/connect"
+)
+
+func TestService_CreateBook(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *validated.CreateBookRequest
+		want    *validated.Book
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &validated.CreateBookRequest{
+				Parent: "parent",
+				Book: &validated.Book{
+					Name:    "name",
+					Title:   "title",
+					Slug:    "slug",
+					Pages:   1,
+					Authors: []string{"authors"},
+					Publisher: &validated.Publisher{
+						Name: "name",
+					},
+					Subtitle: proto.String("subtitle"),
+					Editions: map[string]*validated.Publisher{"key": &validated.Publisher{
+						Name: "name",
+					}},
+					Published: &timestamppb.Timestamp{
+						Seconds: 1,
+						Nanos:   1,
+					},
+					ReadTime: &durationpb.Duration{
+						Seconds: 1,
+						Nanos:   1,
+					},
+					Format: validated.Format_FORMAT_HARDCOVER,
+				},
+			},
+			want: &validated.Book{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			got, err := s.CreateBook(context.Background(), connect.NewRequest(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateBook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil {
+				return
+			}
+			if !proto.Equal(got.Msg, tt.want) {
+				t.Errorf("CreateBook() = %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
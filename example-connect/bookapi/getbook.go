@@ -0,0 +1,16 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+
+	"context"
+
+	connect "connectrpc.com/connect"
+)
+
+// GetBook is a connect rpc implementation of validated.BookAPI.GetBook.
+//
+// GetBook validates its request with google.api.field_behavior annotations.
+func (s *Service) GetBook(ctx context.Context, in *connect.Request[validated.GetBookRequest]) (*connect.Response[validated.Book], error) {
+	return connect.NewResponse(&validated.Book{}), nil
+}
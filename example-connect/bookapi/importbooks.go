@@ -0,0 +1,16 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+
+	"context"
+
+	connect "connectrpc.com/connect"
+)
+
+// ImportBooks implements ImportBooks
+//
+// ImportBooks validates every streamed book.
+func (s *Service) ImportBooks(ctx context.Context, in *connect.ClientStream[validated.Book]) (*connect.Response[validated.ImportBooksResponse], error) {
+	return connect.NewResponse(&validated.ImportBooksResponse{}), nil
+}
@@ -0,0 +1,76 @@
+package validated
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestService_ImportBooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []*validated.Book
+		want    *validated.ImportBooksResponse
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: []*validated.Book{&validated.Book{
+				Name:    "name",
+				Title:   "title",
+				Slug:    "slug",
+				Pages:   1,
+				Authors: []string{"authors"},
+				Publisher: &validated.Publisher{
+					Name: "name",
+				},
+				Subtitle: proto.String("subtitle"),
+				Editions: map[string]*validated.Publisher{"key": &validated.Publisher{
+					Name: "name",
+				}},
+				Published: &timestamppb.Timestamp{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				ReadTime: &durationpb.Duration{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				Format: validated.Format_FORMAT_HARDCOVER,
+			}},
+			want: &validated.ImportBooksResponse{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// connect streams can only be created by a handler, serve Service in memory.
+			client := newTestBookAPIClient(t)
+
+			stream := client.ImportBooks(context.Background())
+			for _, in := range tt.in {
+				// io.EOF is returned when the server has stopped receiving, the cause is returned by CloseAndReceive.
+				if err := stream.Send(in); err != nil && !errors.Is(err, io.EOF) {
+					t.Fatalf("ImportBooks() send error = %v", err)
+				}
+			}
+
+			got, err := stream.CloseAndReceive()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ImportBooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil {
+				return
+			}
+			if !proto.Equal(got.Msg, tt.want) {
+				t.Errorf("ImportBooks() = %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
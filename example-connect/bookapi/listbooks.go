@@ -0,0 +1,16 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+
+	"context"
+
+	connect "connectrpc.com/connect"
+)
+
+// ListBooks implements ListBooks
+//
+// ListBooks streams every book of a shelf.
+func (s *Service) ListBooks(ctx context.Context, in *connect.Request[validated.ListBooksRequest], svr *connect.ServerStream[validated.Book]) error {
+	return nil
+}
@@ -0,0 +1,56 @@
+package validated
+
+import (
+	"context"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+
+	connect "connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestService_ListBooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *validated.ListBooksRequest
+		want    []*validated.Book
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &validated.ListBooksRequest{
+				Parent:   "parent",
+				PageSize: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// connect streams can only be created by a handler, serve Service in memory.
+			client := newTestBookAPIClient(t)
+
+			stream, err := client.ListBooks(context.Background(), connect.NewRequest(tt.in))
+			if err != nil {
+				t.Fatalf("ListBooks() error = %v", err)
+			}
+
+			var got []*validated.Book
+			for stream.Receive() {
+				got = append(got, stream.Msg())
+			}
+			if err := stream.Err(); (err != nil) != tt.wantErr {
+				t.Fatalf("ListBooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ListBooks() received %d messages, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("ListBooks() received[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package validated
+
+import (
+	connectAlias "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated/validatedconnect"
+)
+
+// Service connect implementation of validated.BookAPI.
+//
+// BookAPI exercises request validation driven by field annotations.
+type Service struct {
+	connectAlias.UnimplementedBookAPIHandler
+}
@@ -0,0 +1,74 @@
+package validated
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	connect "connectrpc.com/connect"
+
+	connectAlias "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated/validatedconnect"
+)
+
+// newTestBookAPIClient serves Service on an in memory http/2 server & returns a client connected to it.
+func newTestBookAPIClient(t *testing.T, opts ...connect.ClientOption) connectAlias.BookAPIClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(connectAlias.NewBookAPIHandler(&Service{}))
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return connectAlias.NewBookAPIClient(srv.Client(), srv.URL, opts...)
+}
+
+func TestBookAPIServe(t *testing.T) {
+	client := newTestBookAPIClient(t)
+
+	t.Run("CreateBook", func(t *testing.T) {
+		if _, err := client.CreateBook(context.Background(), connect.NewRequest(&validated.CreateBookRequest{
+			Parent: "parent",
+			Book: &validated.Book{
+				Name:    "name",
+				Title:   "title",
+				Slug:    "slug",
+				Pages:   1,
+				Authors: []string{"authors"},
+				Publisher: &validated.Publisher{
+					Name: "name",
+				},
+				Subtitle: proto.String("subtitle"),
+				Editions: map[string]*validated.Publisher{"key": &validated.Publisher{
+					Name: "name",
+				}},
+				Published: &timestamppb.Timestamp{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				ReadTime: &durationpb.Duration{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				Format: validated.Format_FORMAT_HARDCOVER,
+			},
+		})); err != nil {
+			t.Errorf("CreateBook() error = %v", err)
+		}
+	})
+
+	t.Run("GetBook", func(t *testing.T) {
+		if _, err := client.GetBook(context.Background(), connect.NewRequest(&validated.GetBookRequest{
+			Name: "name",
+		})); err != nil {
+			t.Errorf("GetBook() error = %v", err)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	examplesecondaryapi "github.com/lcmaguire/protoc-gen-go-boilerplate/example-connect/examplesecondaryapi"
+	tempconnect "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp/tempconnect"
+
+	connect "connectrpc.com/connect"
+	protovalidate "github.com/bufbuild/protovalidate-go"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address for the server to listen on")
+	flag.Parse()
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.Fatalf("failed to create validator: %v", err)
+	}
+
+	// interceptors applied to every rpc, every request is validated against its buf.validate constraints.
+	interceptors := []connect.Interceptor{
+		examplesecondaryapi.NewValidateInterceptor(validator),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(tempconnect.NewExampleSecondaryAPIHandler(
+		&examplesecondaryapi.Service{},
+		connect.WithInterceptors(interceptors...),
+	))
+
+	// h2c allows gRPC, gRPC-Web & Connect clients to be served without TLS.
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// stop accepting new requests on SIGINT/SIGTERM & wait for in flight requests to complete.
+	// done is closed once shutdown has completed, as ListenAndServe returns as soon as shutdown begins.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+
+		log.Println("shutting down server")
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Printf("failed to shutdown: %v", err)
+		}
+	}()
+
+	log.Printf("serving proto.ExampleSecondaryAPI on %s", *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("failed to serve: %v", err)
+	}
+	<-done
+	log.Println("server stopped")
+}
@@ -10,6 +10,10 @@ import (
 )
 
 // ExampleAnyRpc is a connect rpc implementation of proto.ExampleAPI.ExampleAnyRpc.
+//
+// ExampleAnyRpc responds with an imported message.
+//
+// Deprecated: proto.ExampleAPI.ExampleAnyRpc is deprecated.
 func (s *Service) ExampleAnyRpc(ctx context.Context, in *connect.Request[temp.Example]) (*connect.Response[anypb.Any], error) {
-	return nil, nil
+	return connect.NewResponse(&anypb.Any{}), nil
 }
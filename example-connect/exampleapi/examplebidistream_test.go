@@ -0,0 +1,95 @@
+package temp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestService_ExampleBidiStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []*temp.Example
+		want    []*temp.Example
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: []*temp.Example{&temp.Example{
+				Name:   "name",
+				Count:  1,
+				Active: true,
+				Tags:   []string{"tags"},
+				Foo: &temp.Foo{
+					Count: 1,
+				},
+				Bar: &temp.Example_Bar{
+					Nested: "nested",
+				},
+				Any: func() *anypb.Any {
+					a, _ := anypb.New(wrapperspb.String("any"))
+					return a
+				}(),
+				Data:          temp.Data_DATA_SPECIFIED,
+				ExtraComments: proto.String("extra_comments"),
+				FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+					Count: 1,
+				}},
+				Sample: &temp.SampleMessage{
+					TestOneof: &temp.SampleMessage_Name{Name: "name"},
+				},
+				AbcOneof: &temp.Example_Abc{Abc: "abc"},
+				Bites:    [][]byte{[]byte("bites")},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// connect streams can only be created by a handler, serve Service in memory.
+			client := newTestExampleAPIClient(t)
+
+			stream := client.ExampleBidiStream(context.Background())
+			for _, in := range tt.in {
+				// io.EOF is returned when the server has stopped receiving, the cause is returned by Receive.
+				if err := stream.Send(in); err != nil && !errors.Is(err, io.EOF) {
+					t.Fatalf("ExampleBidiStream() send error = %v", err)
+				}
+			}
+			if err := stream.CloseRequest(); err != nil {
+				t.Fatalf("ExampleBidiStream() close error = %v", err)
+			}
+
+			var got []*temp.Example
+			var err error
+			for {
+				var out *temp.Example
+				out, err = stream.Receive()
+				if err != nil {
+					break
+				}
+				got = append(got, out)
+			}
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleBidiStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ExampleBidiStream() received %d messages, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("ExampleBidiStream() received[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
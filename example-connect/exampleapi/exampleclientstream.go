@@ -10,5 +10,5 @@ import (
 
 // ExampleClientStream implements ExampleClientStream
 func (s *Service) ExampleClientStream(ctx context.Context, in *connect.ClientStream[temp.Example]) (*connect.Response[temp.Example], error) {
-	return nil, nil
+	return connect.NewResponse(&temp.Example{}), nil
 }
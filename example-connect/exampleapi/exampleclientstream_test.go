@@ -0,0 +1,79 @@
+package temp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestService_ExampleClientStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []*temp.Example
+		want    *temp.Example
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: []*temp.Example{&temp.Example{
+				Name:   "name",
+				Count:  1,
+				Active: true,
+				Tags:   []string{"tags"},
+				Foo: &temp.Foo{
+					Count: 1,
+				},
+				Bar: &temp.Example_Bar{
+					Nested: "nested",
+				},
+				Any: func() *anypb.Any {
+					a, _ := anypb.New(wrapperspb.String("any"))
+					return a
+				}(),
+				Data:          temp.Data_DATA_SPECIFIED,
+				ExtraComments: proto.String("extra_comments"),
+				FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+					Count: 1,
+				}},
+				Sample: &temp.SampleMessage{
+					TestOneof: &temp.SampleMessage_Name{Name: "name"},
+				},
+				AbcOneof: &temp.Example_Abc{Abc: "abc"},
+				Bites:    [][]byte{[]byte("bites")},
+			}},
+			want: &temp.Example{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// connect streams can only be created by a handler, serve Service in memory.
+			client := newTestExampleAPIClient(t)
+
+			stream := client.ExampleClientStream(context.Background())
+			for _, in := range tt.in {
+				// io.EOF is returned when the server has stopped receiving, the cause is returned by CloseAndReceive.
+				if err := stream.Send(in); err != nil && !errors.Is(err, io.EOF) {
+					t.Fatalf("ExampleClientStream() send error = %v", err)
+				}
+			}
+
+			got, err := stream.CloseAndReceive()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleClientStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil {
+				return
+			}
+			if !proto.Equal(got.Msg, tt.want) {
+				t.Errorf("ExampleClientStream() = %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 )
 
 // ExampleRpc is a connect rpc implementation of proto.ExampleAPI.ExampleRpc.
+//
+// ExampleRpc is a unary rpc.
 func (s *Service) ExampleRpc(ctx context.Context, in *connect.Request[temp.Example]) (*connect.Response[temp.Example], error) {
-	return nil, nil
+	return connect.NewResponse(&temp.Example{}), nil
 }
@@ -0,0 +1,69 @@
+package temp
+
+import (
+	"context"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+
+	connect "connectrpc.com/connect"
+)
+
+func TestService_ExampleRpc(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *temp.Example
+		want    *temp.Example
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &temp.Example{
+				Name:   "name",
+				Count:  1,
+				Active: true,
+				Tags:   []string{"tags"},
+				Foo: &temp.Foo{
+					Count: 1,
+				},
+				Bar: &temp.Example_Bar{
+					Nested: "nested",
+				},
+				Any: func() *anypb.Any {
+					a, _ := anypb.New(wrapperspb.String("any"))
+					return a
+				}(),
+				Data:          temp.Data_DATA_SPECIFIED,
+				ExtraComments: proto.String("extra_comments"),
+				FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+					Count: 1,
+				}},
+				Sample: &temp.SampleMessage{
+					TestOneof: &temp.SampleMessage_Name{Name: "name"},
+				},
+				AbcOneof: &temp.Example_Abc{Abc: "abc"},
+				Bites:    [][]byte{[]byte("bites")},
+			},
+			want: &temp.Example{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			got, err := s.ExampleRpc(context.Background(), connect.NewRequest(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleRpc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil {
+				return
+			}
+			if !proto.Equal(got.Msg, tt.want) {
+				t.Errorf("ExampleRpc() = %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,6 @@ import (
 )
 
 // ExampleServerStream implements ExampleServerStream
-func (s *Service) ExampleServerStream(ctx context.Context, in *connect.Request[temp.Example]) (*connect.ServerStream[temp.Example], error) {
-	return nil, nil
+func (s *Service) ExampleServerStream(ctx context.Context, in *connect.Request[temp.Example], svr *connect.ServerStream[temp.Example]) error {
+	return nil
 }
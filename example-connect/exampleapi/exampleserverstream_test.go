@@ -0,0 +1,80 @@
+package temp
+
+import (
+	"context"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+
+	connect "connectrpc.com/connect"
+)
+
+func TestService_ExampleServerStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *temp.Example
+		want    []*temp.Example
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &temp.Example{
+				Name:   "name",
+				Count:  1,
+				Active: true,
+				Tags:   []string{"tags"},
+				Foo: &temp.Foo{
+					Count: 1,
+				},
+				Bar: &temp.Example_Bar{
+					Nested: "nested",
+				},
+				Any: func() *anypb.Any {
+					a, _ := anypb.New(wrapperspb.String("any"))
+					return a
+				}(),
+				Data:          temp.Data_DATA_SPECIFIED,
+				ExtraComments: proto.String("extra_comments"),
+				FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+					Count: 1,
+				}},
+				Sample: &temp.SampleMessage{
+					TestOneof: &temp.SampleMessage_Name{Name: "name"},
+				},
+				AbcOneof: &temp.Example_Abc{Abc: "abc"},
+				Bites:    [][]byte{[]byte("bites")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// connect streams can only be created by a handler, serve Service in memory.
+			client := newTestExampleAPIClient(t)
+
+			stream, err := client.ExampleServerStream(context.Background(), connect.NewRequest(tt.in))
+			if err != nil {
+				t.Fatalf("ExampleServerStream() error = %v", err)
+			}
+
+			var got []*temp.Example
+			for stream.Receive() {
+				got = append(got, stream.Msg())
+			}
+			if err := stream.Err(); (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleServerStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ExampleServerStream() received %d messages, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("ExampleServerStream() received[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
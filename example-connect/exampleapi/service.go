@@ -5,6 +5,8 @@ import (
 )
 
 // Service connect implementation of proto.ExampleAPI.
+//
+// ExampleAPI exercises every kind of rpc.
 type Service struct {
 	connectAlias.UnimplementedExampleAPIHandler
 }
@@ -0,0 +1,97 @@
+package temp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+
+	connect "connectrpc.com/connect"
+
+	connectAlias "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp/tempconnect"
+)
+
+// newTestExampleAPIClient serves Service on an in memory http/2 server & returns a client connected to it.
+func newTestExampleAPIClient(t *testing.T, opts ...connect.ClientOption) connectAlias.ExampleAPIClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(connectAlias.NewExampleAPIHandler(&Service{}))
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return connectAlias.NewExampleAPIClient(srv.Client(), srv.URL, opts...)
+}
+
+func TestExampleAPIServe(t *testing.T) {
+	client := newTestExampleAPIClient(t)
+
+	t.Run("ExampleRpc", func(t *testing.T) {
+		if _, err := client.ExampleRpc(context.Background(), connect.NewRequest(&temp.Example{
+			Name:   "name",
+			Count:  1,
+			Active: true,
+			Tags:   []string{"tags"},
+			Foo: &temp.Foo{
+				Count: 1,
+			},
+			Bar: &temp.Example_Bar{
+				Nested: "nested",
+			},
+			Any: func() *anypb.Any {
+				a, _ := anypb.New(wrapperspb.String("any"))
+				return a
+			}(),
+			Data:          temp.Data_DATA_SPECIFIED,
+			ExtraComments: proto.String("extra_comments"),
+			FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+				Count: 1,
+			}},
+			Sample: &temp.SampleMessage{
+				TestOneof: &temp.SampleMessage_Name{Name: "name"},
+			},
+			AbcOneof: &temp.Example_Abc{Abc: "abc"},
+			Bites:    [][]byte{[]byte("bites")},
+		})); err != nil {
+			t.Errorf("ExampleRpc() error = %v", err)
+		}
+	})
+
+	t.Run("ExampleAnyRpc", func(t *testing.T) {
+		if _, err := client.ExampleAnyRpc(context.Background(), connect.NewRequest(&temp.Example{
+			Name:   "name",
+			Count:  1,
+			Active: true,
+			Tags:   []string{"tags"},
+			Foo: &temp.Foo{
+				Count: 1,
+			},
+			Bar: &temp.Example_Bar{
+				Nested: "nested",
+			},
+			Any: func() *anypb.Any {
+				a, _ := anypb.New(wrapperspb.String("any"))
+				return a
+			}(),
+			Data:          temp.Data_DATA_SPECIFIED,
+			ExtraComments: proto.String("extra_comments"),
+			FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+				Count: 1,
+			}},
+			Sample: &temp.SampleMessage{
+				TestOneof: &temp.SampleMessage_Name{Name: "name"},
+			},
+			AbcOneof: &temp.Example_Abc{Abc: "abc"},
+			Bites:    [][]byte{[]byte("bites")},
+		})); err != nil {
+			t.Errorf("ExampleAnyRpc() error = %v", err)
+		}
+	})
+}
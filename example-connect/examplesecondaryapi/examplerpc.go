@@ -0,0 +1,16 @@
+package temp
+
+import (
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+
+	"context"
+
+	connect "connectrpc.com/connect"
+)
+
+// ExampleRpc is a connect rpc implementation of proto.ExampleSecondaryAPI.ExampleRpc.
+//
+// ExampleRpc shares its name with an rpc of ExampleAPI.
+func (s *Service) ExampleRpc(ctx context.Context, in *connect.Request[temp.Foo]) (*connect.Response[temp.Funk], error) {
+	return connect.NewResponse(&temp.Funk{}), nil
+}
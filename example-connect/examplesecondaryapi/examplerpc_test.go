@@ -0,0 +1,44 @@
+package temp
+
+import (
+	"context"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+
+	connect "connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestService_ExampleRpc(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *temp.Foo
+		want    *temp.Funk
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &temp.Foo{
+				Count: 1,
+			},
+			want: &temp.Funk{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			got, err := s.ExampleRpc(context.Background(), connect.NewRequest(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleRpc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil {
+				return
+			}
+			if !proto.Equal(got.Msg, tt.want) {
+				t.Errorf("ExampleRpc() = %v, want %v", got.Msg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package temp
+
+import (
+	connectAlias "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp/tempconnect"
+)
+
+// Service connect implementation of proto.ExampleSecondaryAPI.
+//
+// ExampleSecondaryAPI is a second service declared within the same file.
+type Service struct {
+	connectAlias.UnimplementedExampleSecondaryAPIHandler
+}
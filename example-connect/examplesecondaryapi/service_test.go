@@ -0,0 +1,40 @@
+package temp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+
+	connect "connectrpc.com/connect"
+
+	connectAlias "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp/tempconnect"
+)
+
+// newTestExampleSecondaryAPIClient serves Service on an in memory http/2 server & returns a client connected to it.
+func newTestExampleSecondaryAPIClient(t *testing.T, opts ...connect.ClientOption) connectAlias.ExampleSecondaryAPIClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(connectAlias.NewExampleSecondaryAPIHandler(&Service{}))
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return connectAlias.NewExampleSecondaryAPIClient(srv.Client(), srv.URL, opts...)
+}
+
+func TestExampleSecondaryAPIServe(t *testing.T) {
+	client := newTestExampleSecondaryAPIClient(t)
+
+	t.Run("ExampleRpc", func(t *testing.T) {
+		if _, err := client.ExampleRpc(context.Background(), connect.NewRequest(&temp.Foo{
+			Count: 1,
+		})); err != nil {
+			t.Errorf("ExampleRpc() error = %v", err)
+		}
+	})
+}
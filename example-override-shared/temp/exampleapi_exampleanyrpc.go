@@ -0,0 +1,75 @@
+package temp
+
+import (
+	"context"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+)
+
+// ExampleAPIServiceExampleAnyRpcStages the stages of proto.ExampleAPI.ExampleAnyRpc, each stage is typed by the result of the previous stage.
+type ExampleAPIServiceExampleAnyRpcStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *Example) (*anypb.Any, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *anypb.Any) (*anypb.Any, error)
+}
+
+// ExampleAnyRpc implements proto.ExampleAPI.ExampleAnyRpc.
+//
+// ExampleAnyRpc responds with an imported message.
+//
+// Deprecated: proto.ExampleAPI.ExampleAnyRpc is deprecated.
+func (s *ExampleAPIService) ExampleAnyRpc(ctx context.Context, in *temp.Example) (*anypb.Any, error) {
+	return runExampleAPIServiceExampleAnyRpc(ctx, &exampleAnyRpcExampleAPIServiceStages{s: s}, in)
+}
+
+// runExampleAPIServiceExampleAnyRpc runs the stages of proto.ExampleAPI.ExampleAnyRpc in order, returning the error of the first stage to fail.
+func runExampleAPIServiceExampleAnyRpc(ctx context.Context, stages ExampleAPIServiceExampleAnyRpcStages, in *temp.Example) (*anypb.Any, error) {
+	if err := stages.Validate(ctx, in); err != nil {
+		return nil, err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// exampleAnyRpcExampleAPIServiceStages implements ExampleAPIServiceExampleAnyRpcStages for the ExampleAPIService.
+type exampleAnyRpcExampleAPIServiceStages struct {
+	s *ExampleAPIService
+}
+
+var _ ExampleAPIServiceExampleAnyRpcStages = (*exampleAnyRpcExampleAPIServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *exampleAnyRpcExampleAPIServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *exampleAnyRpcExampleAPIServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *exampleAnyRpcExampleAPIServiceStages) Persist(ctx context.Context, in *Example) (*anypb.Any, error) {
+	return &anypb.Any{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *exampleAnyRpcExampleAPIServiceStages) Respond(ctx context.Context, out *anypb.Any) (*anypb.Any, error) {
+	return out, nil
+}
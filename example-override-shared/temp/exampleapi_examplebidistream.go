@@ -0,0 +1,90 @@
+package temp
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleAPIServiceExampleBidiStreamStages the stages of proto.ExampleAPI.ExampleBidiStream, each stage is typed by the result of the previous stage.
+type ExampleAPIServiceExampleBidiStreamStages interface {
+	// Validate rejects an invalid request, every streamed request is validated.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps a request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc for a single request.
+	Persist(ctx context.Context, in *Example) (*Example, error)
+	// Respond maps a result of Persist to a response.
+	Respond(ctx context.Context, out *Example) (*temp.Example, error)
+}
+
+// ExampleBidiStream implements proto.ExampleAPI.ExampleBidiStream.
+func (s *ExampleAPIService) ExampleBidiStream(svr temp.ExampleAPI_ExampleBidiStreamServer) error {
+	return runExampleAPIServiceExampleBidiStream(svr.Context(), &exampleBidiStreamExampleAPIServiceStages{s: s}, svr.Recv, svr.Send)
+}
+
+// runExampleAPIServiceExampleBidiStream runs the stages of proto.ExampleAPI.ExampleBidiStream in order for every request until the client closes the stream,
+// returning the error of the first stage to fail.
+func runExampleAPIServiceExampleBidiStream(ctx context.Context, stages ExampleAPIServiceExampleBidiStreamStages, recv func() (*temp.Example, error), send func(*temp.Example) error) error {
+	for {
+		in, err := recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := stages.Validate(ctx, in); err != nil {
+			return err
+		}
+
+		internal, err := stages.Map(ctx, in)
+		if err != nil {
+			return err
+		}
+
+		result, err := stages.Persist(ctx, internal)
+		if err != nil {
+			return err
+		}
+
+		out, err := stages.Respond(ctx, result)
+		if err != nil {
+			return err
+		}
+
+		if err := send(out); err != nil {
+			return err
+		}
+	}
+}
+
+// exampleBidiStreamExampleAPIServiceStages implements ExampleAPIServiceExampleBidiStreamStages for the ExampleAPIService.
+type exampleBidiStreamExampleAPIServiceStages struct {
+	s *ExampleAPIService
+}
+
+var _ ExampleAPIServiceExampleBidiStreamStages = (*exampleBidiStreamExampleAPIServiceStages)(nil)
+
+// Validate validates a request using the validation functions generated by the validation option.
+func (st *exampleBidiStreamExampleAPIServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
+}
+
+// Map maps a request to its domain struct.
+func (st *exampleBidiStreamExampleAPIServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
+}
+
+// Persist performs the rpc for a single request.
+func (st *exampleBidiStreamExampleAPIServiceStages) Persist(ctx context.Context, in *Example) (*Example, error) {
+	return &Example{}, nil
+}
+
+// Respond maps a result to a response.
+func (st *exampleBidiStreamExampleAPIServiceStages) Respond(ctx context.Context, out *Example) (*temp.Example, error) {
+	return out.ToProto(), nil
+}
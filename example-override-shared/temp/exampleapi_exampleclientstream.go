@@ -0,0 +1,89 @@
+package temp
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleAPIServiceExampleClientStreamStages the stages of proto.ExampleAPI.ExampleClientStream, each stage is typed by the result of the previous stage.
+type ExampleAPIServiceExampleClientStreamStages interface {
+	// Validate rejects an invalid request, every streamed request is validated.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps a request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc once every request has been received.
+	Persist(ctx context.Context, in []*Example) (*Example, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Example) (*temp.Example, error)
+}
+
+// ExampleClientStream implements proto.ExampleAPI.ExampleClientStream.
+func (s *ExampleAPIService) ExampleClientStream(svr temp.ExampleAPI_ExampleClientStreamServer) error {
+	out, err := runExampleAPIServiceExampleClientStream(svr.Context(), &exampleClientStreamExampleAPIServiceStages{s: s}, svr.Recv)
+	if err != nil {
+		return err
+	}
+	return svr.SendAndClose(out)
+}
+
+// runExampleAPIServiceExampleClientStream runs the stages of proto.ExampleAPI.ExampleClientStream in order, receiving requests until the client closes the stream,
+// returning the error of the first stage to fail.
+func runExampleAPIServiceExampleClientStream(ctx context.Context, stages ExampleAPIServiceExampleClientStreamStages, recv func() (*temp.Example, error)) (*temp.Example, error) {
+	var internal []*Example
+	for {
+		in, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := stages.Validate(ctx, in); err != nil {
+			return nil, err
+		}
+
+		mapped, err := stages.Map(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		internal = append(internal, mapped)
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// exampleClientStreamExampleAPIServiceStages implements ExampleAPIServiceExampleClientStreamStages for the ExampleAPIService.
+type exampleClientStreamExampleAPIServiceStages struct {
+	s *ExampleAPIService
+}
+
+var _ ExampleAPIServiceExampleClientStreamStages = (*exampleClientStreamExampleAPIServiceStages)(nil)
+
+// Validate validates a request using the validation functions generated by the validation option.
+func (st *exampleClientStreamExampleAPIServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
+}
+
+// Map maps a request to its domain struct.
+func (st *exampleClientStreamExampleAPIServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *exampleClientStreamExampleAPIServiceStages) Persist(ctx context.Context, in []*Example) (*Example, error) {
+	return &Example{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *exampleClientStreamExampleAPIServiceStages) Respond(ctx context.Context, out *Example) (*temp.Example, error) {
+	return out.ToProto(), nil
+}
@@ -0,0 +1,72 @@
+package temp
+
+import (
+	"context"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleAPIServiceExampleRpcStages the stages of proto.ExampleAPI.ExampleRpc, each stage is typed by the result of the previous stage.
+type ExampleAPIServiceExampleRpcStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *Example) (*Example, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Example) (*temp.Example, error)
+}
+
+// ExampleRpc implements proto.ExampleAPI.ExampleRpc.
+//
+// ExampleRpc is a unary rpc.
+func (s *ExampleAPIService) ExampleRpc(ctx context.Context, in *temp.Example) (*temp.Example, error) {
+	return runExampleAPIServiceExampleRpc(ctx, &exampleRpcExampleAPIServiceStages{s: s}, in)
+}
+
+// runExampleAPIServiceExampleRpc runs the stages of proto.ExampleAPI.ExampleRpc in order, returning the error of the first stage to fail.
+func runExampleAPIServiceExampleRpc(ctx context.Context, stages ExampleAPIServiceExampleRpcStages, in *temp.Example) (*temp.Example, error) {
+	if err := stages.Validate(ctx, in); err != nil {
+		return nil, err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// exampleRpcExampleAPIServiceStages implements ExampleAPIServiceExampleRpcStages for the ExampleAPIService.
+type exampleRpcExampleAPIServiceStages struct {
+	s *ExampleAPIService
+}
+
+var _ ExampleAPIServiceExampleRpcStages = (*exampleRpcExampleAPIServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *exampleRpcExampleAPIServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *exampleRpcExampleAPIServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *exampleRpcExampleAPIServiceStages) Persist(ctx context.Context, in *Example) (*Example, error) {
+	return &Example{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *exampleRpcExampleAPIServiceStages) Respond(ctx context.Context, out *Example) (*temp.Example, error) {
+	return out.ToProto(), nil
+}
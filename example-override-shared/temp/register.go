@@ -0,0 +1,12 @@
+package temp
+
+import (
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/grpc"
+)
+
+// RegisterServices registers every service of the package with the server.
+func RegisterServices(s grpc.ServiceRegistrar) {
+	temp.RegisterExampleAPIServer(s, &ExampleAPIService{})
+	temp.RegisterExampleSecondaryAPIServer(s, &ExampleSecondaryAPIService{})
+}
@@ -0,0 +1,72 @@
+package validated
+
+import (
+	"context"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// BookAPIServiceCreateBookStages the stages of validated.BookAPI.CreateBook, each stage is typed by the result of the previous stage.
+type BookAPIServiceCreateBookStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *validated.CreateBookRequest) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *validated.CreateBookRequest) (*CreateBookRequest, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *CreateBookRequest) (*Book, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Book) (*validated.Book, error)
+}
+
+// CreateBook implements validated.BookAPI.CreateBook.
+//
+// CreateBook validates its request with buf.validate constraints.
+func (s *BookAPIService) CreateBook(ctx context.Context, in *validated.CreateBookRequest) (*validated.Book, error) {
+	return runBookAPIServiceCreateBook(ctx, &createBookBookAPIServiceStages{s: s}, in)
+}
+
+// runBookAPIServiceCreateBook runs the stages of validated.BookAPI.CreateBook in order, returning the error of the first stage to fail.
+func runBookAPIServiceCreateBook(ctx context.Context, stages BookAPIServiceCreateBookStages, in *validated.CreateBookRequest) (*validated.Book, error) {
+	if err := stages.Validate(ctx, in); err != nil {
+		return nil, err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// createBookBookAPIServiceStages implements BookAPIServiceCreateBookStages for the BookAPIService.
+type createBookBookAPIServiceStages struct {
+	s *BookAPIService
+}
+
+var _ BookAPIServiceCreateBookStages = (*createBookBookAPIServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *createBookBookAPIServiceStages) Validate(ctx context.Context, in *validated.CreateBookRequest) error {
+	return validateCreateBookRequest(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *createBookBookAPIServiceStages) Map(ctx context.Context, in *validated.CreateBookRequest) (*CreateBookRequest, error) {
+	return CreateBookRequestFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *createBookBookAPIServiceStages) Persist(ctx context.Context, in *CreateBookRequest) (*Book, error) {
+	return &Book{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *createBookBookAPIServiceStages) Respond(ctx context.Context, out *Book) (*validated.Book, error) {
+	return out.ToProto(), nil
+}
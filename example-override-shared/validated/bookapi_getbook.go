@@ -0,0 +1,72 @@
+package validated
+
+import (
+	"context"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// BookAPIServiceGetBookStages the stages of validated.BookAPI.GetBook, each stage is typed by the result of the previous stage.
+type BookAPIServiceGetBookStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *validated.GetBookRequest) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *validated.GetBookRequest) (*GetBookRequest, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *GetBookRequest) (*Book, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Book) (*validated.Book, error)
+}
+
+// GetBook implements validated.BookAPI.GetBook.
+//
+// GetBook validates its request with google.api.field_behavior annotations.
+func (s *BookAPIService) GetBook(ctx context.Context, in *validated.GetBookRequest) (*validated.Book, error) {
+	return runBookAPIServiceGetBook(ctx, &getBookBookAPIServiceStages{s: s}, in)
+}
+
+// runBookAPIServiceGetBook runs the stages of validated.BookAPI.GetBook in order, returning the error of the first stage to fail.
+func runBookAPIServiceGetBook(ctx context.Context, stages BookAPIServiceGetBookStages, in *validated.GetBookRequest) (*validated.Book, error) {
+	if err := stages.Validate(ctx, in); err != nil {
+		return nil, err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// getBookBookAPIServiceStages implements BookAPIServiceGetBookStages for the BookAPIService.
+type getBookBookAPIServiceStages struct {
+	s *BookAPIService
+}
+
+var _ BookAPIServiceGetBookStages = (*getBookBookAPIServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *getBookBookAPIServiceStages) Validate(ctx context.Context, in *validated.GetBookRequest) error {
+	return validateGetBookRequest(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *getBookBookAPIServiceStages) Map(ctx context.Context, in *validated.GetBookRequest) (*GetBookRequest, error) {
+	return GetBookRequestFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *getBookBookAPIServiceStages) Persist(ctx context.Context, in *GetBookRequest) (*Book, error) {
+	return &Book{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *getBookBookAPIServiceStages) Respond(ctx context.Context, out *Book) (*validated.Book, error) {
+	return out.ToProto(), nil
+}
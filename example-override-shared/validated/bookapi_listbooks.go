@@ -0,0 +1,73 @@
+package validated
+
+import (
+	"context"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// BookAPIServiceListBooksStages the stages of validated.BookAPI.ListBooks, each stage is typed by the result of the previous stage.
+type BookAPIServiceListBooksStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *validated.ListBooksRequest) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *validated.ListBooksRequest) (*ListBooksRequest, error)
+	// Persist performs the rpc, calling send for every result to be streamed.
+	Persist(ctx context.Context, in *ListBooksRequest, send func(*Book) error) error
+	// Respond maps a result of Persist to a response.
+	Respond(ctx context.Context, out *Book) (*validated.Book, error)
+}
+
+// ListBooks implements validated.BookAPI.ListBooks.
+//
+// ListBooks streams every book of a shelf.
+func (s *BookAPIService) ListBooks(in *validated.ListBooksRequest, svr validated.BookAPI_ListBooksServer) error {
+	return runBookAPIServiceListBooks(svr.Context(), &listBooksBookAPIServiceStages{s: s}, in, svr.Send)
+}
+
+// runBookAPIServiceListBooks runs the stages of validated.BookAPI.ListBooks in order, sending every response, returning the error of the first stage to fail.
+func runBookAPIServiceListBooks(ctx context.Context, stages BookAPIServiceListBooksStages, in *validated.ListBooksRequest, send func(*validated.Book) error) error {
+	if err := stages.Validate(ctx, in); err != nil {
+		return err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	return stages.Persist(ctx, internal, func(result *Book) error {
+		out, err := stages.Respond(ctx, result)
+		if err != nil {
+			return err
+		}
+		return send(out)
+	})
+}
+
+// listBooksBookAPIServiceStages implements BookAPIServiceListBooksStages for the BookAPIService.
+type listBooksBookAPIServiceStages struct {
+	s *BookAPIService
+}
+
+var _ BookAPIServiceListBooksStages = (*listBooksBookAPIServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *listBooksBookAPIServiceStages) Validate(ctx context.Context, in *validated.ListBooksRequest) error {
+	return validateListBooksRequest(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *listBooksBookAPIServiceStages) Map(ctx context.Context, in *validated.ListBooksRequest) (*ListBooksRequest, error) {
+	return ListBooksRequestFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *listBooksBookAPIServiceStages) Persist(ctx context.Context, in *ListBooksRequest, send func(*Book) error) error {
+	return nil
+}
+
+// Respond maps a result to a response.
+func (st *listBooksBookAPIServiceStages) Respond(ctx context.Context, out *Book) (*validated.Book, error) {
+	return out.ToProto(), nil
+}
@@ -0,0 +1,11 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	"google.golang.org/grpc"
+)
+
+// RegisterServices registers every service of the package with the server.
+func RegisterServices(s grpc.ServiceRegistrar) {
+	validated.RegisterBookAPIServer(s, &BookAPIService{})
+}
@@ -0,0 +1,72 @@
+package validated
+
+import (
+	"context"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// ServiceGetBookStages the stages of validated.BookAPI.GetBook, each stage is typed by the result of the previous stage.
+type ServiceGetBookStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *validated.GetBookRequest) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *validated.GetBookRequest) (*GetBookRequest, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *GetBookRequest) (*Book, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Book) (*validated.Book, error)
+}
+
+// GetBook implements validated.BookAPI.GetBook.
+//
+// GetBook validates its request with google.api.field_behavior annotations.
+func (s *Service) GetBook(ctx context.Context, in *validated.GetBookRequest) (*validated.Book, error) {
+	return runServiceGetBook(ctx, &getBookServiceStages{s: s}, in)
+}
+
+// runServiceGetBook runs the stages of validated.BookAPI.GetBook in order, returning the error of the first stage to fail.
+func runServiceGetBook(ctx context.Context, stages ServiceGetBookStages, in *validated.GetBookRequest) (*validated.Book, error) {
+	if err := stages.Validate(ctx, in); err != nil {
+		return nil, err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// getBookServiceStages implements ServiceGetBookStages for the Service.
+type getBookServiceStages struct {
+	s *Service
+}
+
+var _ ServiceGetBookStages = (*getBookServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *getBookServiceStages) Validate(ctx context.Context, in *validated.GetBookRequest) error {
+	return validateGetBookRequest(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *getBookServiceStages) Map(ctx context.Context, in *validated.GetBookRequest) (*GetBookRequest, error) {
+	return GetBookRequestFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *getBookServiceStages) Persist(ctx context.Context, in *GetBookRequest) (*Book, error) {
+	return &Book{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *getBookServiceStages) Respond(ctx context.Context, out *Book) (*validated.Book, error) {
+	return out.ToProto(), nil
+}
@@ -0,0 +1,91 @@
+package validated
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// ServiceImportBooksStages the stages of validated.BookAPI.ImportBooks, each stage is typed by the result of the previous stage.
+type ServiceImportBooksStages interface {
+	// Validate rejects an invalid request, every streamed request is validated.
+	Validate(ctx context.Context, in *validated.Book) error
+	// Map maps a request to its internal type.
+	Map(ctx context.Context, in *validated.Book) (*Book, error)
+	// Persist performs the rpc once every request has been received.
+	Persist(ctx context.Context, in []*Book) (*ImportBooksResponse, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *ImportBooksResponse) (*validated.ImportBooksResponse, error)
+}
+
+// ImportBooks implements validated.BookAPI.ImportBooks.
+//
+// ImportBooks validates every streamed book.
+func (s *Service) ImportBooks(svr validated.BookAPI_ImportBooksServer) error {
+	out, err := runServiceImportBooks(svr.Context(), &importBooksServiceStages{s: s}, svr.Recv)
+	if err != nil {
+		return err
+	}
+	return svr.SendAndClose(out)
+}
+
+// runServiceImportBooks runs the stages of validated.BookAPI.ImportBooks in order, receiving requests until the client closes the stream,
+// returning the error of the first stage to fail.
+func runServiceImportBooks(ctx context.Context, stages ServiceImportBooksStages, recv func() (*validated.Book, error)) (*validated.ImportBooksResponse, error) {
+	var internal []*Book
+	for {
+		in, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := stages.Validate(ctx, in); err != nil {
+			return nil, err
+		}
+
+		mapped, err := stages.Map(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		internal = append(internal, mapped)
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// importBooksServiceStages implements ServiceImportBooksStages for the Service.
+type importBooksServiceStages struct {
+	s *Service
+}
+
+var _ ServiceImportBooksStages = (*importBooksServiceStages)(nil)
+
+// Validate validates a request using the validation functions generated by the validation option.
+func (st *importBooksServiceStages) Validate(ctx context.Context, in *validated.Book) error {
+	return validateBook(in)
+}
+
+// Map maps a request to its domain struct.
+func (st *importBooksServiceStages) Map(ctx context.Context, in *validated.Book) (*Book, error) {
+	return BookFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *importBooksServiceStages) Persist(ctx context.Context, in []*Book) (*ImportBooksResponse, error) {
+	return &ImportBooksResponse{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *importBooksServiceStages) Respond(ctx context.Context, out *ImportBooksResponse) (*validated.ImportBooksResponse, error) {
+	return out.ToProto(), nil
+}
@@ -7,62 +7,69 @@ import (
 	anypb "google.golang.org/protobuf/types/known/anypb"
 )
 
+// ServiceExampleAnyRpcStages the stages of proto.ExampleAPI.ExampleAnyRpc, each stage is typed by the result of the previous stage.
+type ServiceExampleAnyRpcStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *Example) (*anypb.Any, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *anypb.Any) (*anypb.Any, error)
+}
+
 // ExampleAnyRpc implements proto.ExampleAPI.ExampleAnyRpc.
+//
+// ExampleAnyRpc responds with an imported message.
+//
+// Deprecated: proto.ExampleAPI.ExampleAnyRpc is deprecated.
 func (s *Service) ExampleAnyRpc(ctx context.Context, in *temp.Example) (*anypb.Any, error) {
-	// validate request
-	err := validateExampleAnyRpcInput(ctx, in)
-	if err != nil {
-		return nil, err
-	}
-
-	// map to internal type
-	internalType, err := mapExampleAnyRpcInputToInternal(ctx, in)
-	if err != nil {
-		return nil, err
-	}
+	return runServiceExampleAnyRpc(ctx, &exampleAnyRpcServiceStages{s: s}, in)
+}
 
-	// perform any dowsntream requests prior to database interaction.
-	downstreamResponse, err := s.preDatabaseDownstreamsExampleAnyRpc(ctx, internalType)
-	if err != nil {
+// runServiceExampleAnyRpc runs the stages of proto.ExampleAPI.ExampleAnyRpc in order, returning the error of the first stage to fail.
+func runServiceExampleAnyRpc(ctx context.Context, stages ServiceExampleAnyRpcStages, in *temp.Example) (*anypb.Any, error) {
+	if err := stages.Validate(ctx, in); err != nil {
 		return nil, err
 	}
 
-	// perform database operation
-	databaseResponse, err := s.databaseOpExampleAnyRpc(ctx, downstreamResponse, internalType)
+	internal, err := stages.Map(ctx, in)
 	if err != nil {
 		return nil, err
 	}
 
-	// perform any dowsntream requests post database interaction.
-	postDbDownstreamResponse, err := s.postDatabaseDownstreamsExampleAnyRpc(ctx, databaseResponse)
+	result, err := stages.Persist(ctx, internal)
 	if err != nil {
 		return nil, err
 	}
 
-	// prepare response
-	return prepareExampleAnyRpcResponse(ctx, internalType, downstreamResponse, databaseResponse, postDbDownstreamResponse)
+	return stages.Respond(ctx, result)
 }
 
-func (s *Service) preDatabaseDownstreamsExampleAnyRpc(ctx context.Context, in any) (any, error) {
-	return nil, nil
+// exampleAnyRpcServiceStages implements ServiceExampleAnyRpcStages for the Service.
+type exampleAnyRpcServiceStages struct {
+	s *Service
 }
 
-func (s *Service) databaseOpExampleAnyRpc(ctx context.Context, downstreamResponse any, internalType any) (any, error) {
-	return nil, nil
-}
+var _ ServiceExampleAnyRpcStages = (*exampleAnyRpcServiceStages)(nil)
 
-func (s *Service) postDatabaseDownstreamsExampleAnyRpc(ctx context.Context, in any) (any, error) {
-	return nil, nil
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *exampleAnyRpcServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
 }
 
-func validateExampleAnyRpcInput(ctx context.Context, in *temp.Example) error {
-	return nil
+// Map maps the request to its domain struct.
+func (st *exampleAnyRpcServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
 }
 
-func mapExampleAnyRpcInputToInternal(ctx context.Context, in *temp.Example) (any, error) {
-	return nil, nil
+// Persist performs the rpc.
+func (st *exampleAnyRpcServiceStages) Persist(ctx context.Context, in *Example) (*anypb.Any, error) {
+	return &anypb.Any{}, nil
 }
 
-func prepareExampleAnyRpcResponse(ctx context.Context, downstreamResponse any, internalType any, databaseType any, postDbDownstreamResponse any) (*anypb.Any, error) {
-	return nil, nil
+// Respond maps the result to the response.
+func (st *exampleAnyRpcServiceStages) Respond(ctx context.Context, out *anypb.Any) (*anypb.Any, error) {
+	return out, nil
 }
@@ -1,10 +1,89 @@
 package temp
 
 import (
+	"context"
+	"errors"
+	"io"
+
 	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
 )
 
+// ServiceExampleClientStreamStages the stages of proto.ExampleAPI.ExampleClientStream, each stage is typed by the result of the previous stage.
+type ServiceExampleClientStreamStages interface {
+	// Validate rejects an invalid request, every streamed request is validated.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps a request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc once every request has been received.
+	Persist(ctx context.Context, in []*Example) (*Example, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Example) (*temp.Example, error)
+}
+
 // ExampleClientStream implements proto.ExampleAPI.ExampleClientStream.
-func (s *Service) ExampleClientStream(in temp.ExampleAPI_ExampleClientStreamServer) error {
-	return nil
+func (s *Service) ExampleClientStream(svr temp.ExampleAPI_ExampleClientStreamServer) error {
+	out, err := runServiceExampleClientStream(svr.Context(), &exampleClientStreamServiceStages{s: s}, svr.Recv)
+	if err != nil {
+		return err
+	}
+	return svr.SendAndClose(out)
+}
+
+// runServiceExampleClientStream runs the stages of proto.ExampleAPI.ExampleClientStream in order, receiving requests until the client closes the stream,
+// returning the error of the first stage to fail.
+func runServiceExampleClientStream(ctx context.Context, stages ServiceExampleClientStreamStages, recv func() (*temp.Example, error)) (*temp.Example, error) {
+	var internal []*Example
+	for {
+		in, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := stages.Validate(ctx, in); err != nil {
+			return nil, err
+		}
+
+		mapped, err := stages.Map(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		internal = append(internal, mapped)
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// exampleClientStreamServiceStages implements ServiceExampleClientStreamStages for the Service.
+type exampleClientStreamServiceStages struct {
+	s *Service
+}
+
+var _ ServiceExampleClientStreamStages = (*exampleClientStreamServiceStages)(nil)
+
+// Validate validates a request using the validation functions generated by the validation option.
+func (st *exampleClientStreamServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
+}
+
+// Map maps a request to its domain struct.
+func (st *exampleClientStreamServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *exampleClientStreamServiceStages) Persist(ctx context.Context, in []*Example) (*Example, error) {
+	return &Example{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *exampleClientStreamServiceStages) Respond(ctx context.Context, out *Example) (*temp.Example, error) {
+	return out.ToProto(), nil
 }
@@ -6,62 +6,67 @@ import (
 	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
 )
 
+// ServiceExampleRpcStages the stages of proto.ExampleAPI.ExampleRpc, each stage is typed by the result of the previous stage.
+type ServiceExampleRpcStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *Example) (*Example, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Example) (*temp.Example, error)
+}
+
 // ExampleRpc implements proto.ExampleAPI.ExampleRpc.
+//
+// ExampleRpc is a unary rpc.
 func (s *Service) ExampleRpc(ctx context.Context, in *temp.Example) (*temp.Example, error) {
-	// validate request
-	err := validateExampleRpcInput(ctx, in)
-	if err != nil {
-		return nil, err
-	}
-
-	// map to internal type
-	internalType, err := mapExampleRpcInputToInternal(ctx, in)
-	if err != nil {
-		return nil, err
-	}
+	return runServiceExampleRpc(ctx, &exampleRpcServiceStages{s: s}, in)
+}
 
-	// perform any dowsntream requests prior to database interaction.
-	downstreamResponse, err := s.preDatabaseDownstreamsExampleRpc(ctx, internalType)
-	if err != nil {
+// runServiceExampleRpc runs the stages of proto.ExampleAPI.ExampleRpc in order, returning the error of the first stage to fail.
+func runServiceExampleRpc(ctx context.Context, stages ServiceExampleRpcStages, in *temp.Example) (*temp.Example, error) {
+	if err := stages.Validate(ctx, in); err != nil {
 		return nil, err
 	}
 
-	// perform database operation
-	databaseResponse, err := s.databaseOpExampleRpc(ctx, downstreamResponse, internalType)
+	internal, err := stages.Map(ctx, in)
 	if err != nil {
 		return nil, err
 	}
 
-	// perform any dowsntream requests post database interaction.
-	postDbDownstreamResponse, err := s.postDatabaseDownstreamsExampleRpc(ctx, databaseResponse)
+	result, err := stages.Persist(ctx, internal)
 	if err != nil {
 		return nil, err
 	}
 
-	// prepare response
-	return prepareExampleRpcResponse(ctx, internalType, downstreamResponse, databaseResponse, postDbDownstreamResponse)
+	return stages.Respond(ctx, result)
 }
 
-func (s *Service) preDatabaseDownstreamsExampleRpc(ctx context.Context, in any) (any, error) {
-	return nil, nil
+// exampleRpcServiceStages implements ServiceExampleRpcStages for the Service.
+type exampleRpcServiceStages struct {
+	s *Service
 }
 
-func (s *Service) databaseOpExampleRpc(ctx context.Context, downstreamResponse any, internalType any) (any, error) {
-	return nil, nil
-}
+var _ ServiceExampleRpcStages = (*exampleRpcServiceStages)(nil)
 
-func (s *Service) postDatabaseDownstreamsExampleRpc(ctx context.Context, in any) (any, error) {
-	return nil, nil
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *exampleRpcServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
 }
 
-func validateExampleRpcInput(ctx context.Context, in *temp.Example) error {
-	return nil
+// Map maps the request to its domain struct.
+func (st *exampleRpcServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
 }
 
-func mapExampleRpcInputToInternal(ctx context.Context, in *temp.Example) (any, error) {
-	return nil, nil
+// Persist performs the rpc.
+func (st *exampleRpcServiceStages) Persist(ctx context.Context, in *Example) (*Example, error) {
+	return &Example{}, nil
 }
 
-func prepareExampleRpcResponse(ctx context.Context, downstreamResponse any, internalType any, databaseType any, postDbDownstreamResponse any) (*temp.Example, error) {
-	return nil, nil
+// Respond maps the result to the response.
+func (st *exampleRpcServiceStages) Respond(ctx context.Context, out *Example) (*temp.Example, error) {
+	return out.ToProto(), nil
 }
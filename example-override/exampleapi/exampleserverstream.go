@@ -1,10 +1,71 @@
 package temp
 
 import (
+	"context"
+
 	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
 )
 
+// ServiceExampleServerStreamStages the stages of proto.ExampleAPI.ExampleServerStream, each stage is typed by the result of the previous stage.
+type ServiceExampleServerStreamStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *temp.Example) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *temp.Example) (*Example, error)
+	// Persist performs the rpc, calling send for every result to be streamed.
+	Persist(ctx context.Context, in *Example, send func(*Example) error) error
+	// Respond maps a result of Persist to a response.
+	Respond(ctx context.Context, out *Example) (*temp.Example, error)
+}
+
 // ExampleServerStream implements proto.ExampleAPI.ExampleServerStream.
 func (s *Service) ExampleServerStream(in *temp.Example, svr temp.ExampleAPI_ExampleServerStreamServer) error {
+	return runServiceExampleServerStream(svr.Context(), &exampleServerStreamServiceStages{s: s}, in, svr.Send)
+}
+
+// runServiceExampleServerStream runs the stages of proto.ExampleAPI.ExampleServerStream in order, sending every response, returning the error of the first stage to fail.
+func runServiceExampleServerStream(ctx context.Context, stages ServiceExampleServerStreamStages, in *temp.Example, send func(*temp.Example) error) error {
+	if err := stages.Validate(ctx, in); err != nil {
+		return err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	return stages.Persist(ctx, internal, func(result *Example) error {
+		out, err := stages.Respond(ctx, result)
+		if err != nil {
+			return err
+		}
+		return send(out)
+	})
+}
+
+// exampleServerStreamServiceStages implements ServiceExampleServerStreamStages for the Service.
+type exampleServerStreamServiceStages struct {
+	s *Service
+}
+
+var _ ServiceExampleServerStreamStages = (*exampleServerStreamServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *exampleServerStreamServiceStages) Validate(ctx context.Context, in *temp.Example) error {
+	return validateExample(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *exampleServerStreamServiceStages) Map(ctx context.Context, in *temp.Example) (*Example, error) {
+	return ExampleFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *exampleServerStreamServiceStages) Persist(ctx context.Context, in *Example, send func(*Example) error) error {
 	return nil
 }
+
+// Respond maps a result to a response.
+func (st *exampleServerStreamServiceStages) Respond(ctx context.Context, out *Example) (*temp.Example, error) {
+	return out.ToProto(), nil
+}
@@ -0,0 +1,72 @@
+package temp
+
+import (
+	"context"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ServiceExampleRpcStages the stages of proto.ExampleSecondaryAPI.ExampleRpc, each stage is typed by the result of the previous stage.
+type ServiceExampleRpcStages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *temp.Foo) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *temp.Foo) (*Foo, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in *Foo) (*Funk, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out *Funk) (*temp.Funk, error)
+}
+
+// ExampleRpc implements proto.ExampleSecondaryAPI.ExampleRpc.
+//
+// ExampleRpc shares its name with an rpc of ExampleAPI.
+func (s *Service) ExampleRpc(ctx context.Context, in *temp.Foo) (*temp.Funk, error) {
+	return runServiceExampleRpc(ctx, &exampleRpcServiceStages{s: s}, in)
+}
+
+// runServiceExampleRpc runs the stages of proto.ExampleSecondaryAPI.ExampleRpc in order, returning the error of the first stage to fail.
+func runServiceExampleRpc(ctx context.Context, stages ServiceExampleRpcStages, in *temp.Foo) (*temp.Funk, error) {
+	if err := stages.Validate(ctx, in); err != nil {
+		return nil, err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// exampleRpcServiceStages implements ServiceExampleRpcStages for the Service.
+type exampleRpcServiceStages struct {
+	s *Service
+}
+
+var _ ServiceExampleRpcStages = (*exampleRpcServiceStages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *exampleRpcServiceStages) Validate(ctx context.Context, in *temp.Foo) error {
+	return validateFoo(in)
+}
+
+// Map maps the request to its domain struct.
+func (st *exampleRpcServiceStages) Map(ctx context.Context, in *temp.Foo) (*Foo, error) {
+	return FooFromProto(in), nil
+}
+
+// Persist performs the rpc.
+func (st *exampleRpcServiceStages) Persist(ctx context.Context, in *Foo) (*Funk, error) {
+	return &Funk{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *exampleRpcServiceStages) Respond(ctx context.Context, out *Funk) (*temp.Funk, error) {
+	return out.ToProto(), nil
+}
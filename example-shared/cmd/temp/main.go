@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	temp1 "github.com/lcmaguire/protoc-gen-go-boilerplate/example-shared/temp"
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address for the server to listen on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *addr, err)
+	}
+
+	srv := grpc.NewServer()
+	temp.RegisterExampleAPIServer(srv, &temp1.ExampleAPIService{})
+	temp.RegisterExampleSecondaryAPIServer(srv, &temp1.ExampleSecondaryAPIService{})
+
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	healthSrv.SetServingStatus("proto.ExampleAPI", healthpb.HealthCheckResponse_SERVING)
+	healthSrv.SetServingStatus("proto.ExampleSecondaryAPI", healthpb.HealthCheckResponse_SERVING)
+
+	reflection.Register(srv)
+
+	// stop accepting new rpcs on SIGINT/SIGTERM & wait for in flight rpcs to complete.
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+
+		log.Println("shutting down server")
+		healthSrv.Shutdown()
+		srv.GracefulStop()
+	}()
+
+	log.Printf("serving proto.ExampleAPI proto.ExampleSecondaryAPI on %s", lis.Addr())
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	validated1 "github.com/lcmaguire/protoc-gen-go-boilerplate/example-shared/validated"
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address for the server to listen on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *addr, err)
+	}
+
+	srv := grpc.NewServer()
+	validated.RegisterBookAPIServer(srv, &validated1.BookAPIService{})
+
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	healthSrv.SetServingStatus("validated.BookAPI", healthpb.HealthCheckResponse_SERVING)
+
+	reflection.Register(srv)
+
+	// stop accepting new rpcs on SIGINT/SIGTERM & wait for in flight rpcs to complete.
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+
+		log.Println("shutting down server")
+		healthSrv.Shutdown()
+		srv.GracefulStop()
+	}()
+
+	log.Printf("serving validated.BookAPI on %s", lis.Addr())
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+package temp
+
+import (
+	"context"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+)
+
+// ExampleAnyRpc implements proto.ExampleAPI.ExampleAnyRpc.
+//
+// ExampleAnyRpc responds with an imported message.
+//
+// Deprecated: proto.ExampleAPI.ExampleAnyRpc is deprecated.
+func (s *ExampleAPIService) ExampleAnyRpc(ctx context.Context, in *temp.Example) (*anypb.Any, error) {
+	return &anypb.Any{}, nil
+}
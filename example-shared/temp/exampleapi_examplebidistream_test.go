@@ -0,0 +1,97 @@
+package temp
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/grpc"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fakeExampleAPIServiceExampleBidiStreamServer an in memory temp.ExampleAPI_ExampleBidiStreamServer which receives queued messages & records sent messages.
+type fakeExampleAPIServiceExampleBidiStreamServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	in   []*temp.Example
+	sent []*temp.Example
+}
+
+func (f *fakeExampleAPIServiceExampleBidiStreamServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeExampleAPIServiceExampleBidiStreamServer) Recv() (*temp.Example, error) {
+	if len(f.in) == 0 {
+		return nil, io.EOF
+	}
+	in := f.in[0]
+	f.in = f.in[1:]
+	return in, nil
+}
+
+func (f *fakeExampleAPIServiceExampleBidiStreamServer) Send(out *temp.Example) error {
+	f.sent = append(f.sent, out)
+	return nil
+}
+
+func TestExampleAPIService_ExampleBidiStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []*temp.Example
+		want    []*temp.Example
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: []*temp.Example{&temp.Example{
+				Name:   "name",
+				Count:  1,
+				Active: true,
+				Tags:   []string{"tags"},
+				Foo: &temp.Foo{
+					Count: 1,
+				},
+				Bar: &temp.Example_Bar{
+					Nested: "nested",
+				},
+				Any: func() *anypb.Any {
+					a, _ := anypb.New(wrapperspb.String("any"))
+					return a
+				}(),
+				Data:          temp.Data_DATA_SPECIFIED,
+				ExtraComments: proto.String("extra_comments"),
+				FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+					Count: 1,
+				}},
+				Sample: &temp.SampleMessage{
+					TestOneof: &temp.SampleMessage_Name{Name: "name"},
+				},
+				AbcOneof: &temp.Example_Abc{Abc: "abc"},
+				Bites:    [][]byte{[]byte("bites")},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &ExampleAPIService{}
+			svr := &fakeExampleAPIServiceExampleBidiStreamServer{ctx: context.Background(), in: tt.in}
+			err := s.ExampleBidiStream(svr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleBidiStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(svr.sent) != len(tt.want) {
+				t.Fatalf("ExampleBidiStream() sent %d messages, want %d", len(svr.sent), len(tt.want))
+			}
+			for i := range tt.want {
+				if !proto.Equal(svr.sent[i], tt.want[i]) {
+					t.Errorf("ExampleBidiStream() sent[%d] = %v, want %v", i, svr.sent[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
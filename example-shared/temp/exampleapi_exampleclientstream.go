@@ -0,0 +1,10 @@
+package temp
+
+import (
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleClientStream implements proto.ExampleAPI.ExampleClientStream.
+func (s *ExampleAPIService) ExampleClientStream(in temp.ExampleAPI_ExampleClientStreamServer) error {
+	return nil
+}
@@ -0,0 +1,14 @@
+package temp
+
+import (
+	"context"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleRpc implements proto.ExampleAPI.ExampleRpc.
+//
+// ExampleRpc is a unary rpc.
+func (s *ExampleAPIService) ExampleRpc(ctx context.Context, in *temp.Example) (*temp.Example, error) {
+	return &temp.Example{}, nil
+}
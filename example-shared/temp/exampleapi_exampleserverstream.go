@@ -0,0 +1,10 @@
+package temp
+
+import (
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleServerStream implements proto.ExampleAPI.ExampleServerStream.
+func (s *ExampleAPIService) ExampleServerStream(in *temp.Example, svr temp.ExampleAPI_ExampleServerStreamServer) error {
+	return nil
+}
@@ -0,0 +1,12 @@
+package temp
+
+import (
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleAPIService implements proto.ExampleAPI.
+//
+// ExampleAPI exercises every kind of rpc.
+type ExampleAPIService struct {
+	temp.UnimplementedExampleAPIServer
+}
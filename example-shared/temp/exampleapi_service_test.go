@@ -0,0 +1,109 @@
+package temp
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// newTestExampleAPIClient serves ExampleAPIService on an in memory bufconn listener & returns a client connected to it.
+func newTestExampleAPIClient(t *testing.T) temp.ExampleAPIClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	temp.RegisterExampleAPIServer(srv, &ExampleAPIService{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return temp.NewExampleAPIClient(conn)
+}
+
+func TestExampleAPIServe(t *testing.T) {
+	client := newTestExampleAPIClient(t)
+
+	t.Run("ExampleRpc", func(t *testing.T) {
+		if _, err := client.ExampleRpc(context.Background(), &temp.Example{
+			Name:   "name",
+			Count:  1,
+			Active: true,
+			Tags:   []string{"tags"},
+			Foo: &temp.Foo{
+				Count: 1,
+			},
+			Bar: &temp.Example_Bar{
+				Nested: "nested",
+			},
+			Any: func() *anypb.Any {
+				a, _ := anypb.New(wrapperspb.String("any"))
+				return a
+			}(),
+			Data:          temp.Data_DATA_SPECIFIED,
+			ExtraComments: proto.String("extra_comments"),
+			FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+				Count: 1,
+			}},
+			Sample: &temp.SampleMessage{
+				TestOneof: &temp.SampleMessage_Name{Name: "name"},
+			},
+			AbcOneof: &temp.Example_Abc{Abc: "abc"},
+			Bites:    [][]byte{[]byte("bites")},
+		}); err != nil {
+			t.Errorf("ExampleRpc() error = %v", err)
+		}
+	})
+
+	t.Run("ExampleAnyRpc", func(t *testing.T) {
+		if _, err := client.ExampleAnyRpc(context.Background(), &temp.Example{
+			Name:   "name",
+			Count:  1,
+			Active: true,
+			Tags:   []string{"tags"},
+			Foo: &temp.Foo{
+				Count: 1,
+			},
+			Bar: &temp.Example_Bar{
+				Nested: "nested",
+			},
+			Any: func() *anypb.Any {
+				a, _ := anypb.New(wrapperspb.String("any"))
+				return a
+			}(),
+			Data:          temp.Data_DATA_SPECIFIED,
+			ExtraComments: proto.String("extra_comments"),
+			FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+				Count: 1,
+			}},
+			Sample: &temp.SampleMessage{
+				TestOneof: &temp.SampleMessage_Name{Name: "name"},
+			},
+			AbcOneof: &temp.Example_Abc{Abc: "abc"},
+			Bites:    [][]byte{[]byte("bites")},
+		}); err != nil {
+			t.Errorf("ExampleAnyRpc() error = %v", err)
+		}
+	})
+}
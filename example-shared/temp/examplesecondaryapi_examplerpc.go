@@ -0,0 +1,14 @@
+package temp
+
+import (
+	"context"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// ExampleRpc implements proto.ExampleSecondaryAPI.ExampleRpc.
+//
+// ExampleRpc shares its name with an rpc of ExampleAPI.
+func (s *ExampleSecondaryAPIService) ExampleRpc(ctx context.Context, in *temp.Foo) (*temp.Funk, error) {
+	return &temp.Funk{}, nil
+}
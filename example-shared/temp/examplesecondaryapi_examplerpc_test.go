@@ -0,0 +1,39 @@
+package temp
+
+import (
+	"context"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExampleSecondaryAPIService_ExampleRpc(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *temp.Foo
+		want    *temp.Funk
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &temp.Foo{
+				Count: 1,
+			},
+			want: &temp.Funk{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &ExampleSecondaryAPIService{}
+			got, err := s.ExampleRpc(context.Background(), tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleRpc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("ExampleRpc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package validated
+
+import (
+	"context"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// CreateBook implements validated.BookAPI.CreateBook.
+//
+// CreateBook validates its request with buf.validate constraints.
+func (s *BookAPIService) CreateBook(ctx context.Context, in *validated.CreateBookRequest) (*validated.Book, error) {
+	return &validated.Book{}, nil
+}
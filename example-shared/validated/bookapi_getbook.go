@@ -0,0 +1,14 @@
+package validated
+
+import (
+	"context"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// GetBook implements validated.BookAPI.GetBook.
+//
+// GetBook validates its request with google.api.field_behavior annotations.
+func (s *BookAPIService) GetBook(ctx context.Context, in *validated.GetBookRequest) (*validated.Book, error) {
+	return &validated.Book{}, nil
+}
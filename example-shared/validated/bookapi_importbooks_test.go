@@ -0,0 +1,89 @@
+package validated
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	"google.golang.org/grpc"
+	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeBookAPIServiceImportBooksServer an in memory validated.BookAPI_ImportBooksServer which receives queued messages.
+type fakeBookAPIServiceImportBooksServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	in   []*validated.Book
+	resp *validated.ImportBooksResponse
+}
+
+func (f *fakeBookAPIServiceImportBooksServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeBookAPIServiceImportBooksServer) Recv() (*validated.Book, error) {
+	if len(f.in) == 0 {
+		return nil, io.EOF
+	}
+	in := f.in[0]
+	f.in = f.in[1:]
+	return in, nil
+}
+
+func (f *fakeBookAPIServiceImportBooksServer) SendAndClose(out *validated.ImportBooksResponse) error {
+	f.resp = out
+	return nil
+}
+
+func TestBookAPIService_ImportBooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []*validated.Book
+		want    *validated.ImportBooksResponse
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: []*validated.Book{&validated.Book{
+				Name:    "name",
+				Title:   "title",
+				Slug:    "slug",
+				Pages:   1,
+				Authors: []string{"authors"},
+				Publisher: &validated.Publisher{
+					Name: "name",
+				},
+				Subtitle: proto.String("subtitle"),
+				Editions: map[string]*validated.Publisher{"key": &validated.Publisher{
+					Name: "name",
+				}},
+				Published: &timestamppb.Timestamp{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				ReadTime: &durationpb.Duration{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				Format: validated.Format_FORMAT_HARDCOVER,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &BookAPIService{}
+			svr := &fakeBookAPIServiceImportBooksServer{ctx: context.Background(), in: tt.in}
+			err := s.ImportBooks(svr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ImportBooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !proto.Equal(svr.resp, tt.want) {
+				t.Errorf("ImportBooks() = %v, want %v", svr.resp, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package validated
+
+import (
+	"context"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeBookAPIServiceListBooksServer an in memory validated.BookAPI_ListBooksServer which records sent messages.
+type fakeBookAPIServiceListBooksServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*validated.Book
+}
+
+func (f *fakeBookAPIServiceListBooksServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeBookAPIServiceListBooksServer) Send(out *validated.Book) error {
+	f.sent = append(f.sent, out)
+	return nil
+}
+
+func TestBookAPIService_ListBooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *validated.ListBooksRequest
+		want    []*validated.Book
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &validated.ListBooksRequest{
+				Parent:   "parent",
+				PageSize: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &BookAPIService{}
+			svr := &fakeBookAPIServiceListBooksServer{ctx: context.Background()}
+			err := s.ListBooks(tt.in, svr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListBooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(svr.sent) != len(tt.want) {
+				t.Fatalf("ListBooks() sent %d messages, want %d", len(svr.sent), len(tt.want))
+			}
+			for i := range tt.want {
+				if !proto.Equal(svr.sent[i], tt.want[i]) {
+					t.Errorf("ListBooks() sent[%d] = %v, want %v", i, svr.sent[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// BookAPIService implements validated.BookAPI.
+//
+// BookAPI exercises request validation driven by field annotations.
+type BookAPIService struct {
+	validated.UnimplementedBookAPIServer
+}
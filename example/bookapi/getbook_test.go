@@ -0,0 +1,39 @@
+package validated
+
+import (
+	"context"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestService_GetBook(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *validated.GetBookRequest
+		want    *validated.Book
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &validated.GetBookRequest{
+				Name: "name",
+			},
+			want: &validated.Book{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			got, err := s.GetBook(context.Background(), tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetBook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("GetBook() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
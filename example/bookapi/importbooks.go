@@ -0,0 +1,12 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// ImportBooks implements validated.BookAPI.ImportBooks.
+//
+// ImportBooks validates every streamed book.
+func (s *Service) ImportBooks(in validated.BookAPI_ImportBooksServer) error {
+	return nil
+}
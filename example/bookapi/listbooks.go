@@ -0,0 +1,12 @@
+package validated
+
+import (
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+)
+
+// ListBooks implements validated.BookAPI.ListBooks.
+//
+// ListBooks streams every book of a shelf.
+func (s *Service) ListBooks(in *validated.ListBooksRequest, svr validated.BookAPI_ListBooksServer) error {
+	return nil
+}
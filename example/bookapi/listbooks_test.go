@@ -0,0 +1,62 @@
+package validated
+
+import (
+	"context"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeServiceListBooksServer an in memory validated.BookAPI_ListBooksServer which records sent messages.
+type fakeServiceListBooksServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*validated.Book
+}
+
+func (f *fakeServiceListBooksServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServiceListBooksServer) Send(out *validated.Book) error {
+	f.sent = append(f.sent, out)
+	return nil
+}
+
+func TestService_ListBooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *validated.ListBooksRequest
+		want    []*validated.Book
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &validated.ListBooksRequest{
+				Parent:   "parent",
+				PageSize: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			svr := &fakeServiceListBooksServer{ctx: context.Background()}
+			err := s.ListBooks(tt.in, svr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListBooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(svr.sent) != len(tt.want) {
+				t.Fatalf("ListBooks() sent %d messages, want %d", len(svr.sent), len(tt.want))
+			}
+			for i := range tt.want {
+				if !proto.Equal(svr.sent[i], tt.want[i]) {
+					t.Errorf("ListBooks() sent[%d] = %v, want %v", i, svr.sent[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
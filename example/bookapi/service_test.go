@@ -0,0 +1,86 @@
+package validated
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestBookAPIClient serves Service on an in memory bufconn listener & returns a client connected to it.
+func newTestBookAPIClient(t *testing.T) validated.BookAPIClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	validated.RegisterBookAPIServer(srv, &Service{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return validated.NewBookAPIClient(conn)
+}
+
+func TestBookAPIServe(t *testing.T) {
+	client := newTestBookAPIClient(t)
+
+	t.Run("CreateBook", func(t *testing.T) {
+		if _, err := client.CreateBook(context.Background(), &validated.CreateBookRequest{
+			Parent: "parent",
+			Book: &validated.Book{
+				Name:    "name",
+				Title:   "title",
+				Slug:    "slug",
+				Pages:   1,
+				Authors: []string{"authors"},
+				Publisher: &validated.Publisher{
+					Name: "name",
+				},
+				Subtitle: proto.String("subtitle"),
+				Editions: map[string]*validated.Publisher{"key": &validated.Publisher{
+					Name: "name",
+				}},
+				Published: &timestamppb.Timestamp{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				ReadTime: &durationpb.Duration{
+					Seconds: 1,
+					Nanos:   1,
+				},
+				Format: validated.Format_FORMAT_HARDCOVER,
+			},
+		}); err != nil {
+			t.Errorf("CreateBook() error = %v", err)
+		}
+	})
+
+	t.Run("GetBook", func(t *testing.T) {
+		if _, err := client.GetBook(context.Background(), &validated.GetBookRequest{
+			Name: "name",
+		}); err != nil {
+			t.Errorf("GetBook() error = %v", err)
+		}
+	})
+}
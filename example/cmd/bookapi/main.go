@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	bookapi "github.com/lcmaguire/protoc-gen-go-boilerplate/example/bookapi"
+	validated "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/validated"
+
+	protovalidate "github.com/bufbuild/protovalidate-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address for the server to listen on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", *addr, err)
+	}
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.Fatalf("failed to create validator: %v", err)
+	}
+
+	// every request is validated against its buf.validate constraints.
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(bookapi.ValidateUnaryInterceptor(validator)),
+		grpc.ChainStreamInterceptor(bookapi.ValidateStreamInterceptor(validator)),
+	)
+	validated.RegisterBookAPIServer(srv, &bookapi.Service{})
+
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	healthSrv.SetServingStatus("validated.BookAPI", healthpb.HealthCheckResponse_SERVING)
+
+	reflection.Register(srv)
+
+	// stop accepting new rpcs on SIGINT/SIGTERM & wait for in flight rpcs to complete.
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		<-sig
+
+		log.Println("shutting down server")
+		healthSrv.Shutdown()
+		srv.GracefulStop()
+	}()
+
+	log.Printf("serving validated.BookAPI on %s", lis.Addr())
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -8,6 +8,10 @@ import (
 )
 
 // ExampleAnyRpc implements proto.ExampleAPI.ExampleAnyRpc.
+//
+// ExampleAnyRpc responds with an imported message.
+//
+// Deprecated: proto.ExampleAPI.ExampleAnyRpc is deprecated.
 func (s *Service) ExampleAnyRpc(ctx context.Context, in *temp.Example) (*anypb.Any, error) {
-	return nil, nil
+	return &anypb.Any{}, nil
 }
@@ -0,0 +1,92 @@
+package temp
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/grpc"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fakeServiceExampleClientStreamServer an in memory temp.ExampleAPI_ExampleClientStreamServer which receives queued messages.
+type fakeServiceExampleClientStreamServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	in   []*temp.Example
+	resp *temp.Example
+}
+
+func (f *fakeServiceExampleClientStreamServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServiceExampleClientStreamServer) Recv() (*temp.Example, error) {
+	if len(f.in) == 0 {
+		return nil, io.EOF
+	}
+	in := f.in[0]
+	f.in = f.in[1:]
+	return in, nil
+}
+
+func (f *fakeServiceExampleClientStreamServer) SendAndClose(out *temp.Example) error {
+	f.resp = out
+	return nil
+}
+
+func TestService_ExampleClientStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []*temp.Example
+		want    *temp.Example
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: []*temp.Example{&temp.Example{
+				Name:   "name",
+				Count:  1,
+				Active: true,
+				Tags:   []string{"tags"},
+				Foo: &temp.Foo{
+					Count: 1,
+				},
+				Bar: &temp.Example_Bar{
+					Nested: "nested",
+				},
+				Any: func() *anypb.Any {
+					a, _ := anypb.New(wrapperspb.String("any"))
+					return a
+				}(),
+				Data:          temp.Data_DATA_SPECIFIED,
+				ExtraComments: proto.String("extra_comments"),
+				FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+					Count: 1,
+				}},
+				Sample: &temp.SampleMessage{
+					TestOneof: &temp.SampleMessage_Name{Name: "name"},
+				},
+				AbcOneof: &temp.Example_Abc{Abc: "abc"},
+				Bites:    [][]byte{[]byte("bites")},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			svr := &fakeServiceExampleClientStreamServer{ctx: context.Background(), in: tt.in}
+			err := s.ExampleClientStream(svr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleClientStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !proto.Equal(svr.resp, tt.want) {
+				t.Errorf("ExampleClientStream() = %v, want %v", svr.resp, tt.want)
+			}
+		})
+	}
+}
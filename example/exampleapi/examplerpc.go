@@ -7,6 +7,8 @@ import (
 )
 
 // ExampleRpc implements proto.ExampleAPI.ExampleRpc.
+//
+// ExampleRpc is a unary rpc.
 func (s *Service) ExampleRpc(ctx context.Context, in *temp.Example) (*temp.Example, error) {
-	return nil, nil
+	return &temp.Example{}, nil
 }
@@ -0,0 +1,86 @@
+package temp
+
+import (
+	"context"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/grpc"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fakeServiceExampleServerStreamServer an in memory temp.ExampleAPI_ExampleServerStreamServer which records sent messages.
+type fakeServiceExampleServerStreamServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*temp.Example
+}
+
+func (f *fakeServiceExampleServerStreamServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServiceExampleServerStreamServer) Send(out *temp.Example) error {
+	f.sent = append(f.sent, out)
+	return nil
+}
+
+func TestService_ExampleServerStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *temp.Example
+		want    []*temp.Example
+		wantErr bool
+	}{
+		{
+			name: "default",
+			in: &temp.Example{
+				Name:   "name",
+				Count:  1,
+				Active: true,
+				Tags:   []string{"tags"},
+				Foo: &temp.Foo{
+					Count: 1,
+				},
+				Bar: &temp.Example_Bar{
+					Nested: "nested",
+				},
+				Any: func() *anypb.Any {
+					a, _ := anypb.New(wrapperspb.String("any"))
+					return a
+				}(),
+				Data:          temp.Data_DATA_SPECIFIED,
+				ExtraComments: proto.String("extra_comments"),
+				FooMap: map[string]*temp.Foo{"key": &temp.Foo{
+					Count: 1,
+				}},
+				Sample: &temp.SampleMessage{
+					TestOneof: &temp.SampleMessage_Name{Name: "name"},
+				},
+				AbcOneof: &temp.Example_Abc{Abc: "abc"},
+				Bites:    [][]byte{[]byte("bites")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{}
+			svr := &fakeServiceExampleServerStreamServer{ctx: context.Background()}
+			err := s.ExampleServerStream(tt.in, svr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExampleServerStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(svr.sent) != len(tt.want) {
+				t.Fatalf("ExampleServerStream() sent %d messages, want %d", len(svr.sent), len(tt.want))
+			}
+			for i := range tt.want {
+				if !proto.Equal(svr.sent[i], tt.want[i]) {
+					t.Errorf("ExampleServerStream() sent[%d] = %v, want %v", i, svr.sent[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
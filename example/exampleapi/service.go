@@ -5,6 +5,8 @@ import (
 )
 
 // Service implements proto.ExampleAPI.
+//
+// ExampleAPI exercises every kind of rpc.
 type Service struct {
 	temp.UnimplementedExampleAPIServer
 }
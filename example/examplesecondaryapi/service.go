@@ -0,0 +1,12 @@
+package temp
+
+import (
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+// Service implements proto.ExampleSecondaryAPI.
+//
+// ExampleSecondaryAPI is a second service declared within the same file.
+type Service struct {
+	temp.UnimplementedExampleSecondaryAPIServer
+}
@@ -0,0 +1,52 @@
+package temp
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestExampleSecondaryAPIClient serves Service on an in memory bufconn listener & returns a client connected to it.
+func newTestExampleSecondaryAPIClient(t *testing.T) temp.ExampleSecondaryAPIClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	temp.RegisterExampleSecondaryAPIServer(srv, &Service{})
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial bufnet: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return temp.NewExampleSecondaryAPIClient(conn)
+}
+
+func TestExampleSecondaryAPIServe(t *testing.T) {
+	client := newTestExampleSecondaryAPIClient(t)
+
+	t.Run("ExampleRpc", func(t *testing.T) {
+		if _, err := client.ExampleRpc(context.Background(), &temp.Foo{
+			Count: 1,
+		}); err != nil {
+			t.Errorf("ExampleRpc() error = %v", err)
+		}
+	})
+}
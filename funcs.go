@@ -0,0 +1,191 @@
+package main
+
+import (
+	"strings"
+	"text/template"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// funcMap returns the functions available to every template.
+//
+// go identifiers are qualified relative to f, adding any required imports to the generated file.
+// f is nil when templates are parsed as functions are only required to be defined prior to execution.
+func funcMap(f *protogen.GeneratedFile) template.FuncMap {
+	return template.FuncMap{
+		// case conversion.
+		"lower":      strings.ToLower,
+		"lowerCamel": lowerCamel,
+		"upperCamel": upperCamel,
+		"snake":      snake,
+		"kebab":      kebab,
+		"plural":     plural,
+
+		// qualify returns the go identifier qualified by its package e.g foo.Bar.
+		"qualify": func(ident protogen.GoIdent) string {
+			return f.QualifiedGoIdent(ident)
+		},
+		// goType returns the go type of a single value of a field e.g *foo.Bar.
+		"goType": func(field *protogen.Field) string {
+			return goType(f, field)
+		},
+		// sample renders a go composite literal of the message populated with non zero values.
+		"sample": func(message *protogen.Message) string {
+			return sampleMessage(f, message, map[protoreflect.FullName]bool{})
+		},
+
+		// proto helpers.
+		"leadingComments":   leadingComments,
+		"trailingComments":  trailingComments,
+		"fieldsOf":          fieldsOf,
+		"isStreaming":       isStreaming,
+		"isClientStreaming": isClientStreaming,
+		"isServerStreaming": isServerStreaming,
+		"httpRule":          httpRule,
+
+		// allowImport declares imports which may be added to files rendered from the template when resolving imports
+		// hermetically, rendering nothing.
+		allowImportFunc: func(...string) string { return "" },
+	}
+}
+
+// allowImportFunc name of the template function declaring the imports a template allows.
+const allowImportFunc = "allowImport"
+
+// commentSet returns the comments of a protogen type, the zero value is returned for unsupported types.
+func commentSet(v any) protogen.CommentSet {
+	switch v := v.(type) {
+	case *protogen.Service:
+		return v.Comments
+	case *protogen.Method:
+		return v.Comments
+	case *protogen.Message:
+		return v.Comments
+	case *protogen.Field:
+		return v.Comments
+	case *protogen.Oneof:
+		return v.Comments
+	case *protogen.Enum:
+		return v.Comments
+	case *protogen.EnumValue:
+		return v.Comments
+	}
+	return protogen.CommentSet{}
+}
+
+// leadingComments returns the leading proto comments of a service, method, message, field, oneof or enum as go comments.
+func leadingComments(v any) string {
+	return commentSet(v).Leading.String()
+}
+
+// trailingComments returns the trailing proto comments of a service, method, message, field, oneof or enum as go comments.
+func trailingComments(v any) string {
+	return commentSet(v).Trailing.String()
+}
+
+// fieldsOf returns the fields of a message in the order they are declared.
+func fieldsOf(message *protogen.Message) []*protogen.Field {
+	return message.Fields
+}
+
+// isStreaming reports whether either the client or server streams.
+func isStreaming(method *protogen.Method) bool {
+	return method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer()
+}
+
+// isClientStreaming reports whether the client streams.
+func isClientStreaming(method *protogen.Method) bool {
+	return method.Desc.IsStreamingClient()
+}
+
+// isServerStreaming reports whether the server streams.
+func isServerStreaming(method *protogen.Method) bool {
+	return method.Desc.IsStreamingServer()
+}
+
+// httpRule returns the google.api.http annotation of the method, nil when the method is not annotated.
+func httpRule(method *protogen.Method) *annotations.HttpRule {
+	rule, _ := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
+	return rule
+}
+
+// words splits an identifier into its lower case words.
+//
+// words are separated by underscores, hyphens, spaces & changes in case, acronyms are kept as a single word e.g ExampleAPI is example api.
+func words(s string) []string {
+	var out []string
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			out = append(out, strings.ToLower(string(current)))
+			current = current[:0]
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ' || r == '.':
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return out
+}
+
+// upperCamel converts an identifier to UpperCamelCase e.g foo_bar is FooBar.
+func upperCamel(s string) string {
+	var b strings.Builder
+	for _, word := range words(s) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// lowerCamel converts an identifier to lowerCamelCase e.g FooBar is fooBar.
+func lowerCamel(s string) string {
+	camel := upperCamel(s)
+	if camel == "" {
+		return camel
+	}
+	return strings.ToLower(camel[:1]) + camel[1:]
+}
+
+// snake converts an identifier to snake_case e.g FooBar is foo_bar.
+func snake(s string) string {
+	return strings.Join(words(s), "_")
+}
+
+// kebab converts an identifier to kebab-case e.g FooBar is foo-bar.
+func kebab(s string) string {
+	return strings.Join(words(s), "-")
+}
+
+// plural returns the english plural of a noun e.g Entry is Entries.
+func plural(s string) string {
+	lower := strings.ToLower(s)
+	switch {
+	case lower == "":
+		return s
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return s + "es"
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return s[:len(s)-1] + "ies"
+	}
+	return s + "s"
+}
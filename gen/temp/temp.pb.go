@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.33.0
+// 	protoc-gen-go v1.34.2
 // 	protoc        (unknown)
 // source: temp/temp.proto
 
@@ -92,7 +92,6 @@ type Example struct {
 	// oneofs
 	Sample *SampleMessage `protobuf:"bytes,11,opt,name=sample,proto3" json:"sample,omitempty"`
 	// Types that are assignable to AbcOneof:
-	//
 	//	*Example_Abc
 	//	*Example_Far_
 	AbcOneof isExample_AbcOneof `protobuf_oneof:"abc_oneof"`
@@ -352,7 +351,6 @@ type SampleMessage struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to TestOneof:
-	//
 	//	*SampleMessage_Name
 	//	*SampleMessage_Foo
 	//	*SampleMessage_Funk
@@ -595,34 +593,38 @@ var file_temp_temp_proto_rawDesc = []byte{
 	0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x2a, 0x30, 0x0a, 0x04, 0x44,
 	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x55, 0x4e, 0x53, 0x50,
 	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x41, 0x54,
-	0x41, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x32, 0x9c, 0x02,
+	0x41, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x32, 0xa1, 0x02,
 	0x0a, 0x0a, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x41, 0x50, 0x49, 0x12, 0x2c, 0x0a, 0x0a,
 	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x70, 0x63, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x45, 0x78,
+	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x45, 0x78,
 	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x41, 0x6e, 0x79, 0x52, 0x70, 0x63, 0x12, 0x0e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x14, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x12, 0x37, 0x0a, 0x13, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x28, 0x01, 0x12, 0x37, 0x0a, 0x13, 0x45, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x69,
-	0x64, 0x69, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x83, 0x01, 0x0a,
-	0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x09, 0x54, 0x65, 0x6d, 0x70,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x63, 0x6d, 0x61, 0x67, 0x75, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x62, 0x6f, 0x69, 0x6c,
-	0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x65, 0x6d, 0x70,
-	0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0xca, 0x02,
-	0x05, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0xe2, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x37, 0x0a, 0x13, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x28, 0x01, 0x12,
+	0x37, 0x0a, 0x13, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x42, 0x69, 0x64, 0x69, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x28, 0x01, 0x30,
+	0x01, 0x32, 0x3c, 0x0a, 0x13, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x50, 0x49, 0x12, 0x25, 0x0a, 0x0a, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x52, 0x70, 0x63, 0x12, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x6f, 0x6f, 0x1a, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x75, 0x6e, 0x6b, 0x42,
+	0x83, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x09, 0x54,
+	0x65, 0x6d, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x63, 0x6d, 0x61, 0x67, 0x75, 0x69, 0x72, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x62,
+	0x6f, 0x69, 0x6c, 0x65, 0x72, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74,
+	0x65, 0x6d, 0x70, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0xca, 0x02, 0x05, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0xe2, 0x02, 0x11, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -639,7 +641,7 @@ func file_temp_temp_proto_rawDescGZIP() []byte {
 
 var file_temp_temp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_temp_temp_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_temp_temp_proto_goTypes = []interface{}{
+var file_temp_temp_proto_goTypes = []any{
 	(Data)(0),             // 0: proto.Data
 	(*Example)(nil),       // 1: proto.Example
 	(*Foo)(nil),           // 2: proto.Foo
@@ -666,13 +668,15 @@ var file_temp_temp_proto_depIdxs = []int32{
 	1,  // 12: proto.ExampleAPI.ExampleClientStream:input_type -> proto.Example
 	1,  // 13: proto.ExampleAPI.ExampleServerStream:input_type -> proto.Example
 	1,  // 14: proto.ExampleAPI.ExampleBidiStream:input_type -> proto.Example
-	1,  // 15: proto.ExampleAPI.ExampleRpc:output_type -> proto.Example
-	8,  // 16: proto.ExampleAPI.ExampleAnyRpc:output_type -> google.protobuf.Any
-	1,  // 17: proto.ExampleAPI.ExampleClientStream:output_type -> proto.Example
-	1,  // 18: proto.ExampleAPI.ExampleServerStream:output_type -> proto.Example
-	1,  // 19: proto.ExampleAPI.ExampleBidiStream:output_type -> proto.Example
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
+	2,  // 15: proto.ExampleSecondaryAPI.ExampleRpc:input_type -> proto.Foo
+	1,  // 16: proto.ExampleAPI.ExampleRpc:output_type -> proto.Example
+	8,  // 17: proto.ExampleAPI.ExampleAnyRpc:output_type -> google.protobuf.Any
+	1,  // 18: proto.ExampleAPI.ExampleClientStream:output_type -> proto.Example
+	1,  // 19: proto.ExampleAPI.ExampleServerStream:output_type -> proto.Example
+	1,  // 20: proto.ExampleAPI.ExampleBidiStream:output_type -> proto.Example
+	3,  // 21: proto.ExampleSecondaryAPI.ExampleRpc:output_type -> proto.Funk
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
 	10, // [10:10] is the sub-list for extension type_name
 	10, // [10:10] is the sub-list for extension extendee
 	0,  // [0:10] is the sub-list for field type_name
@@ -684,7 +688,7 @@ func file_temp_temp_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_temp_temp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_temp_temp_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*Example); i {
 			case 0:
 				return &v.state
@@ -696,7 +700,7 @@ func file_temp_temp_proto_init() {
 				return nil
 			}
 		}
-		file_temp_temp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_temp_temp_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*Foo); i {
 			case 0:
 				return &v.state
@@ -708,7 +712,7 @@ func file_temp_temp_proto_init() {
 				return nil
 			}
 		}
-		file_temp_temp_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_temp_temp_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*Funk); i {
 			case 0:
 				return &v.state
@@ -720,7 +724,7 @@ func file_temp_temp_proto_init() {
 				return nil
 			}
 		}
-		file_temp_temp_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_temp_temp_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*SampleMessage); i {
 			case 0:
 				return &v.state
@@ -732,7 +736,7 @@ func file_temp_temp_proto_init() {
 				return nil
 			}
 		}
-		file_temp_temp_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_temp_temp_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*Example_Bar); i {
 			case 0:
 				return &v.state
@@ -744,7 +748,7 @@ func file_temp_temp_proto_init() {
 				return nil
 			}
 		}
-		file_temp_temp_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_temp_temp_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*Example_Far); i {
 			case 0:
 				return &v.state
@@ -757,11 +761,11 @@ func file_temp_temp_proto_init() {
 			}
 		}
 	}
-	file_temp_temp_proto_msgTypes[0].OneofWrappers = []interface{}{
+	file_temp_temp_proto_msgTypes[0].OneofWrappers = []any{
 		(*Example_Abc)(nil),
 		(*Example_Far_)(nil),
 	}
-	file_temp_temp_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_temp_temp_proto_msgTypes[3].OneofWrappers = []any{
 		(*SampleMessage_Name)(nil),
 		(*SampleMessage_Foo)(nil),
 		(*SampleMessage_Funk)(nil),
@@ -774,7 +778,7 @@ func file_temp_temp_proto_init() {
 			NumEnums:      1,
 			NumMessages:   7,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_temp_temp_proto_goTypes,
 		DependencyIndexes: file_temp_temp_proto_depIdxs,
@@ -31,7 +31,10 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ExampleAPIClient interface {
+	// ExampleRpc is a unary rpc.
 	ExampleRpc(ctx context.Context, in *Example, opts ...grpc.CallOption) (*Example, error)
+	// Deprecated: Do not use.
+	// ExampleAnyRpc responds with an imported message.
 	ExampleAnyRpc(ctx context.Context, in *Example, opts ...grpc.CallOption) (*anypb.Any, error)
 	ExampleClientStream(ctx context.Context, opts ...grpc.CallOption) (ExampleAPI_ExampleClientStreamClient, error)
 	ExampleServerStream(ctx context.Context, in *Example, opts ...grpc.CallOption) (ExampleAPI_ExampleServerStreamClient, error)
@@ -55,6 +58,7 @@ func (c *exampleAPIClient) ExampleRpc(ctx context.Context, in *Example, opts ...
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *exampleAPIClient) ExampleAnyRpc(ctx context.Context, in *Example, opts ...grpc.CallOption) (*anypb.Any, error) {
 	out := new(anypb.Any)
 	err := c.cc.Invoke(ctx, ExampleAPI_ExampleAnyRpc_FullMethodName, in, out, opts...)
@@ -165,7 +169,10 @@ func (x *exampleAPIExampleBidiStreamClient) Recv() (*Example, error) {
 // All implementations must embed UnimplementedExampleAPIServer
 // for forward compatibility
 type ExampleAPIServer interface {
+	// ExampleRpc is a unary rpc.
 	ExampleRpc(context.Context, *Example) (*Example, error)
+	// Deprecated: Do not use.
+	// ExampleAnyRpc responds with an imported message.
 	ExampleAnyRpc(context.Context, *Example) (*anypb.Any, error)
 	ExampleClientStream(ExampleAPI_ExampleClientStreamServer) error
 	ExampleServerStream(*Example, ExampleAPI_ExampleServerStreamServer) error
@@ -350,3 +357,95 @@ var ExampleAPI_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "temp/temp.proto",
 }
+
+const (
+	ExampleSecondaryAPI_ExampleRpc_FullMethodName = "/proto.ExampleSecondaryAPI/ExampleRpc"
+)
+
+// ExampleSecondaryAPIClient is the client API for ExampleSecondaryAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExampleSecondaryAPIClient interface {
+	// ExampleRpc shares its name with an rpc of ExampleAPI.
+	ExampleRpc(ctx context.Context, in *Foo, opts ...grpc.CallOption) (*Funk, error)
+}
+
+type exampleSecondaryAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExampleSecondaryAPIClient(cc grpc.ClientConnInterface) ExampleSecondaryAPIClient {
+	return &exampleSecondaryAPIClient{cc}
+}
+
+func (c *exampleSecondaryAPIClient) ExampleRpc(ctx context.Context, in *Foo, opts ...grpc.CallOption) (*Funk, error) {
+	out := new(Funk)
+	err := c.cc.Invoke(ctx, ExampleSecondaryAPI_ExampleRpc_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExampleSecondaryAPIServer is the server API for ExampleSecondaryAPI service.
+// All implementations must embed UnimplementedExampleSecondaryAPIServer
+// for forward compatibility
+type ExampleSecondaryAPIServer interface {
+	// ExampleRpc shares its name with an rpc of ExampleAPI.
+	ExampleRpc(context.Context, *Foo) (*Funk, error)
+	mustEmbedUnimplementedExampleSecondaryAPIServer()
+}
+
+// UnimplementedExampleSecondaryAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedExampleSecondaryAPIServer struct {
+}
+
+func (UnimplementedExampleSecondaryAPIServer) ExampleRpc(context.Context, *Foo) (*Funk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExampleRpc not implemented")
+}
+func (UnimplementedExampleSecondaryAPIServer) mustEmbedUnimplementedExampleSecondaryAPIServer() {}
+
+// UnsafeExampleSecondaryAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExampleSecondaryAPIServer will
+// result in compilation errors.
+type UnsafeExampleSecondaryAPIServer interface {
+	mustEmbedUnimplementedExampleSecondaryAPIServer()
+}
+
+func RegisterExampleSecondaryAPIServer(s grpc.ServiceRegistrar, srv ExampleSecondaryAPIServer) {
+	s.RegisterService(&ExampleSecondaryAPI_ServiceDesc, srv)
+}
+
+func _ExampleSecondaryAPI_ExampleRpc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Foo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleSecondaryAPIServer).ExampleRpc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleSecondaryAPI_ExampleRpc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleSecondaryAPIServer).ExampleRpc(ctx, req.(*Foo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExampleSecondaryAPI_ServiceDesc is the grpc.ServiceDesc for ExampleSecondaryAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExampleSecondaryAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ExampleSecondaryAPI",
+	HandlerType: (*ExampleSecondaryAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExampleRpc",
+			Handler:    _ExampleSecondaryAPI_ExampleRpc_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temp/temp.proto",
+}
@@ -24,6 +24,8 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// ExampleAPIName is the fully-qualified name of the ExampleAPI service.
 	ExampleAPIName = "proto.ExampleAPI"
+	// ExampleSecondaryAPIName is the fully-qualified name of the ExampleSecondaryAPI service.
+	ExampleSecondaryAPIName = "proto.ExampleSecondaryAPI"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -48,6 +50,9 @@ const (
 	// ExampleAPIExampleBidiStreamProcedure is the fully-qualified name of the ExampleAPI's
 	// ExampleBidiStream RPC.
 	ExampleAPIExampleBidiStreamProcedure = "/proto.ExampleAPI/ExampleBidiStream"
+	// ExampleSecondaryAPIExampleRpcProcedure is the fully-qualified name of the ExampleSecondaryAPI's
+	// ExampleRpc RPC.
+	ExampleSecondaryAPIExampleRpcProcedure = "/proto.ExampleSecondaryAPI/ExampleRpc"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -58,11 +63,17 @@ var (
 	exampleAPIExampleClientStreamMethodDescriptor = exampleAPIServiceDescriptor.Methods().ByName("ExampleClientStream")
 	exampleAPIExampleServerStreamMethodDescriptor = exampleAPIServiceDescriptor.Methods().ByName("ExampleServerStream")
 	exampleAPIExampleBidiStreamMethodDescriptor   = exampleAPIServiceDescriptor.Methods().ByName("ExampleBidiStream")
+	exampleSecondaryAPIServiceDescriptor          = temp.File_temp_temp_proto.Services().ByName("ExampleSecondaryAPI")
+	exampleSecondaryAPIExampleRpcMethodDescriptor = exampleSecondaryAPIServiceDescriptor.Methods().ByName("ExampleRpc")
 )
 
 // ExampleAPIClient is a client for the proto.ExampleAPI service.
 type ExampleAPIClient interface {
+	// ExampleRpc is a unary rpc.
 	ExampleRpc(context.Context, *connect.Request[temp.Example]) (*connect.Response[temp.Example], error)
+	// ExampleAnyRpc responds with an imported message.
+	//
+	// Deprecated: do not use.
 	ExampleAnyRpc(context.Context, *connect.Request[temp.Example]) (*connect.Response[anypb.Any], error)
 	ExampleClientStream(context.Context) *connect.ClientStreamForClient[temp.Example, temp.Example]
 	ExampleServerStream(context.Context, *connect.Request[temp.Example]) (*connect.ServerStreamForClient[temp.Example], error)
@@ -127,6 +138,8 @@ func (c *exampleAPIClient) ExampleRpc(ctx context.Context, req *connect.Request[
 }
 
 // ExampleAnyRpc calls proto.ExampleAPI.ExampleAnyRpc.
+//
+// Deprecated: do not use.
 func (c *exampleAPIClient) ExampleAnyRpc(ctx context.Context, req *connect.Request[temp.Example]) (*connect.Response[anypb.Any], error) {
 	return c.exampleAnyRpc.CallUnary(ctx, req)
 }
@@ -148,7 +161,11 @@ func (c *exampleAPIClient) ExampleBidiStream(ctx context.Context) *connect.BidiS
 
 // ExampleAPIHandler is an implementation of the proto.ExampleAPI service.
 type ExampleAPIHandler interface {
+	// ExampleRpc is a unary rpc.
 	ExampleRpc(context.Context, *connect.Request[temp.Example]) (*connect.Response[temp.Example], error)
+	// ExampleAnyRpc responds with an imported message.
+	//
+	// Deprecated: do not use.
 	ExampleAnyRpc(context.Context, *connect.Request[temp.Example]) (*connect.Response[anypb.Any], error)
 	ExampleClientStream(context.Context, *connect.ClientStream[temp.Example]) (*connect.Response[temp.Example], error)
 	ExampleServerStream(context.Context, *connect.Request[temp.Example], *connect.ServerStream[temp.Example]) error
@@ -231,3 +248,73 @@ func (UnimplementedExampleAPIHandler) ExampleServerStream(context.Context, *conn
 func (UnimplementedExampleAPIHandler) ExampleBidiStream(context.Context, *connect.BidiStream[temp.Example, temp.Example]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("proto.ExampleAPI.ExampleBidiStream is not implemented"))
 }
+
+// ExampleSecondaryAPIClient is a client for the proto.ExampleSecondaryAPI service.
+type ExampleSecondaryAPIClient interface {
+	// ExampleRpc shares its name with an rpc of ExampleAPI.
+	ExampleRpc(context.Context, *connect.Request[temp.Foo]) (*connect.Response[temp.Funk], error)
+}
+
+// NewExampleSecondaryAPIClient constructs a client for the proto.ExampleSecondaryAPI service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewExampleSecondaryAPIClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ExampleSecondaryAPIClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &exampleSecondaryAPIClient{
+		exampleRpc: connect.NewClient[temp.Foo, temp.Funk](
+			httpClient,
+			baseURL+ExampleSecondaryAPIExampleRpcProcedure,
+			connect.WithSchema(exampleSecondaryAPIExampleRpcMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// exampleSecondaryAPIClient implements ExampleSecondaryAPIClient.
+type exampleSecondaryAPIClient struct {
+	exampleRpc *connect.Client[temp.Foo, temp.Funk]
+}
+
+// ExampleRpc calls proto.ExampleSecondaryAPI.ExampleRpc.
+func (c *exampleSecondaryAPIClient) ExampleRpc(ctx context.Context, req *connect.Request[temp.Foo]) (*connect.Response[temp.Funk], error) {
+	return c.exampleRpc.CallUnary(ctx, req)
+}
+
+// ExampleSecondaryAPIHandler is an implementation of the proto.ExampleSecondaryAPI service.
+type ExampleSecondaryAPIHandler interface {
+	// ExampleRpc shares its name with an rpc of ExampleAPI.
+	ExampleRpc(context.Context, *connect.Request[temp.Foo]) (*connect.Response[temp.Funk], error)
+}
+
+// NewExampleSecondaryAPIHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewExampleSecondaryAPIHandler(svc ExampleSecondaryAPIHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	exampleSecondaryAPIExampleRpcHandler := connect.NewUnaryHandler(
+		ExampleSecondaryAPIExampleRpcProcedure,
+		svc.ExampleRpc,
+		connect.WithSchema(exampleSecondaryAPIExampleRpcMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/proto.ExampleSecondaryAPI/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ExampleSecondaryAPIExampleRpcProcedure:
+			exampleSecondaryAPIExampleRpcHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedExampleSecondaryAPIHandler returns CodeUnimplemented from all methods.
+type UnimplementedExampleSecondaryAPIHandler struct{}
+
+func (UnimplementedExampleSecondaryAPIHandler) ExampleRpc(context.Context, *connect.Request[temp.Foo]) (*connect.Response[temp.Funk], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.ExampleSecondaryAPI.ExampleRpc is not implemented"))
+}
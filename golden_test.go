@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+var update = flag.Bool("update", false, "update the committed examples with the generated files")
+
+// bufGen the parts of a buf.gen yaml file used to generate the examples.
+type bufGen struct {
+	Plugins []struct {
+		Local string    `yaml:"local"`
+		Out   string    `yaml:"out"`
+		Opt   bufGenOpt `yaml:"opt"`
+	} `yaml:"plugins"`
+}
+
+// bufGenOpt the options of a plugin, either a single option or a list of options.
+type bufGenOpt []string
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (o *bufGenOpt) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*o = []string{value.Value}
+		return nil
+	}
+	return value.Decode((*[]string)(o))
+}
+
+// TestGolden generates the examples of every buf.gen yaml file without buf or network access, comparing the generated
+// files to the committed examples. buf runs local plugins once per directory, so each directory is generated separately.
+func TestGolden(t *testing.T) {
+	configs, err := filepath.Glob("buf.gen*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dirs, err := os.ReadDir("proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, config := range configs {
+		bites, err := os.ReadFile(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var gen bufGen
+		if err := yaml.Unmarshal(bites, &gen); err != nil {
+			t.Fatalf("%s: %v", config, err)
+		}
+
+		for _, plugin := range gen.Plugins {
+			if plugin.Local != "protoc-gen-go-boilerplate" {
+				continue
+			}
+
+			for _, dir := range dirs {
+				files, err := filepath.Glob(filepath.Join("proto", dir.Name(), "*.proto"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for i := range files {
+					files[i] = filepath.ToSlash(strings.TrimPrefix(files[i], "proto"+string(filepath.Separator)))
+				}
+
+				t.Run(config+"/"+dir.Name(), func(t *testing.T) {
+					for fileName, content := range generateFiles(t, compileRequest(t, "proto", strings.Join(plugin.Opt, ","), files...)) {
+						golden := filepath.Join(plugin.Out, filepath.FromSlash(fileName))
+						if *update {
+							if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+								t.Fatal(err)
+							}
+							if err := os.WriteFile(golden, []byte(content), 0o644); err != nil {
+								t.Fatal(err)
+							}
+							continue
+						}
+
+						want, err := os.ReadFile(golden)
+						if err != nil {
+							t.Errorf("%s: %v", golden, err)
+							continue
+						}
+						if string(want) != content {
+							t.Errorf("%s does not match the generated file, regenerate the examples via go test -run TestGolden -update\n%s", golden, content)
+						}
+					}
+				})
+			}
+		}
+	}
+}
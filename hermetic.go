@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+// defaultAllowedImports standard library packages templates may use without importing them in hermetic mode.
+var defaultAllowedImports = []string{
+	"context",
+	"errors",
+	"fmt",
+	"io",
+	"log",
+	"log/slog",
+	"net",
+	"net/http",
+	"os",
+	"os/signal",
+	"strconv",
+	"strings",
+	"sync",
+	"syscall",
+	"testing",
+	"time",
+}
+
+// importAllowList packages keyed by name which may be imported when resolving imports hermetically.
+type importAllowList map[string]string
+
+// String implements flag.Value.
+func (a importAllowList) String() string {
+	allowed := make([]string, 0, len(a))
+	for name, importPath := range a {
+		allowed = append(allowed, name+"="+importPath)
+	}
+	sort.Strings(allowed)
+	return strings.Join(allowed, ",")
+}
+
+// Set implements flag.Value, parsing an import path e.g log/slog or a named import path e.g yaml=gopkg.in/yaml.v3.
+func (a importAllowList) Set(value string) error {
+	name, importPath, ok := strings.Cut(value, "=")
+	if !ok {
+		name, importPath = assumedPackageName(value), value
+	}
+
+	if !token.IsIdentifier(name) || importPath == "" {
+		return fmt.Errorf("allowed import %q must be of the form path or name=path", value)
+	}
+	a[name] = importPath
+	return nil
+}
+
+// with returns a copy of the allow list including the imports of other, which take precedence.
+func (a importAllowList) with(other importAllowList) importAllowList {
+	allowed := make(importAllowList, len(a)+len(other))
+	for name, importPath := range a {
+		allowed[name] = importPath
+	}
+	for name, importPath := range other {
+		allowed[name] = importPath
+	}
+	return allowed
+}
+
+// templateImports returns the imports a template allows via the allowImport function e.g {{allowImport "github.com/google/uuid"}},
+// only applying to files rendered from the template. the arguments must be string literals.
+func templateImports(t *template.Template) (importAllowList, error) {
+	allowed := importAllowList{}
+	if t == nil || t.Tree == nil {
+		return allowed, nil
+	}
+
+	var err error
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch node := node.(type) {
+		case *parse.ListNode:
+			if node == nil {
+				return
+			}
+			for _, n := range node.Nodes {
+				walk(n)
+			}
+		case *parse.IfNode:
+			walk(node.List)
+			walk(node.ElseList)
+		case *parse.RangeNode:
+			walk(node.List)
+			walk(node.ElseList)
+		case *parse.WithNode:
+			walk(node.List)
+			walk(node.ElseList)
+		case *parse.ActionNode:
+			for _, cmd := range node.Pipe.Cmds {
+				if ident, ok := cmd.Args[0].(*parse.IdentifierNode); !ok || ident.Ident != allowImportFunc {
+					continue
+				}
+				for _, arg := range cmd.Args[1:] {
+					value, ok := arg.(*parse.StringNode)
+					if !ok {
+						err = fmt.Errorf("%s: %s arguments must be strings, got %s", t.Name(), allowImportFunc, arg)
+						return
+					}
+					if setErr := allowed.Set(value.Text); setErr != nil {
+						err = fmt.Errorf("%s: %w", t.Name(), setErr)
+						return
+					}
+				}
+			}
+		}
+	}
+	walk(t.Tree.Root)
+	return allowed, err
+}
+
+// hermeticImports returns a post processor tidying imports without consulting the go command, GOPATH or module cache.
+//
+// unused imports are removed & packages referred to without being imported are only resolved from the allow list,
+// imports are then grouped & sorted as goimports would. the output only depends on the rendered file & allow list.
+func hermeticImports(allowed importAllowList) postProcessor {
+	return func(fileName string, content []byte) ([]byte, error) {
+		content, err := dedupeImports(content)
+		if err != nil {
+			return nil, err
+		}
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, fileName, content, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		used := referencedPackages(f)
+
+		// specs are deleted once every import has been inspected, as deleting modifies f.Imports.
+		var unused []*ast.ImportSpec
+		imported := map[string]bool{}
+		for _, spec := range f.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			name := assumedPackageName(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+
+			// blank & dot imports are kept as their use can not be determined.
+			if name == "_" || name == "." || used[name] {
+				imported[name] = true
+				continue
+			}
+
+			unused = append(unused, spec)
+		}
+		for _, spec := range unused {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			astutil.DeleteNamedImport(fset, f, importName(spec), importPath)
+		}
+
+		missing := make([]string, 0, len(used))
+		for name := range used {
+			if !imported[name] {
+				missing = append(missing, name)
+			}
+		}
+		sort.Strings(missing)
+
+		for _, name := range missing {
+			// names which are not allowed may refer to declarations within another file of the package,
+			// otherwise the generated code will fail to compile.
+			importPath, ok := allowed[name]
+			if !ok {
+				continue
+			}
+
+			if assumedPackageName(importPath) == name {
+				astutil.AddImport(fset, f, importPath)
+				continue
+			}
+			astutil.AddNamedImport(fset, f, name, importPath)
+		}
+
+		buf := bytes.NewBuffer([]byte{})
+		if err := format.Node(buf, fset, f); err != nil {
+			return nil, err
+		}
+
+		// imports are only grouped & sorted.
+		return imports.Process(fileName, buf.Bytes(), &imports.Options{Comments: true, TabIndent: true, TabWidth: 8, FormatOnly: true})
+	}
+}
+
+// referencedPackages returns the names of the packages the file refers to e.g context for context.Context.
+func referencedPackages(f *ast.File) map[string]bool {
+	unresolved := map[*ast.Ident]bool{}
+	for _, ident := range f.Unresolved {
+		unresolved[ident] = true
+	}
+
+	used := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if ident, ok := sel.X.(*ast.Ident); ok && unresolved[ident] {
+			used[ident.Name] = true
+		}
+		return true
+	})
+	return used
+}
+
+// importName returns the name an import has been declared with, empty when unnamed.
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name == nil {
+		return ""
+	}
+	return spec.Name.Name
+}
+
+// assumedPackageName returns the package name assumed for an import path, as goimports does.
+//
+// e.g yaml for gopkg.in/yaml.v3 & connect for connectrpc.com/connect.
+func assumedPackageName(importPath string) string {
+	base := path.Base(importPath)
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil && path.Dir(importPath) != "." {
+			base = path.Base(path.Dir(importPath))
+		}
+	}
+
+	base = strings.TrimPrefix(base, "go-")
+	if i := strings.IndexFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i >= 0 {
+		base = base[:i]
+	}
+	return base
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestHermeticImportsCompile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code using the go command")
+	}
+
+	// the options of the buf.gen yaml files.
+	tests := map[string][]string{
+		"example": {"server=true", "tests=true", "builders=true", "messages=true", "validation=true", "validate=true", "domain=true"},
+		"example-connect": {
+			"templateDirectory=templates/connect", "server=true", "tests=true", "builders=true", "messages=true", "validation=true", "validate=true", "domain=true",
+		},
+		"example-override": {
+			"unaryMethodTemplate=method.fleshed.go.tpl", "serverStreamMethodTemplate=method.fleshed.server.stream.go.tpl",
+			"clientStreamMethodTemplate=method.fleshed.client.stream.go.tpl", "bidiStreamMethodTemplate=method.fleshed.bidi.stream.go.tpl",
+			"validation=true", "domain=true",
+		},
+		"example-override-shared": {
+			"unaryMethodTemplate=method.fleshed.go.tpl", "serverStreamMethodTemplate=method.fleshed.server.stream.go.tpl",
+			"clientStreamMethodTemplate=method.fleshed.client.stream.go.tpl", "bidiStreamMethodTemplate=method.fleshed.bidi.stream.go.tpl",
+			"validation=true", "domain=true", "sharedPackage=true",
+		},
+		"example-shared": {"server=true", "tests=true", "builders=true", "messages=true", "sharedPackage=true"},
+	}
+
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := tempDir(t)
+			opts = append(opts, "hermeticImports=true", "importPath=github.com/lcmaguire/protoc-gen-go-boilerplate/"+dir)
+			writeFiles(t, dir, generateFiles(t, compileRequest(t, "proto", strings.Join(opts, ","))))
+
+			// vet type checks the test files as well as the packages.
+			out, err := exec.Command("go", "vet", "./"+dir+"/...").CombinedOutput()
+			if err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
+
+func TestTemplateImports(t *testing.T) {
+	tests := map[string]struct {
+		template string
+		want     importAllowList
+		err      string
+	}{
+		"none": {
+			template: "func F() {}",
+			want:     importAllowList{},
+		},
+		"nested": {
+			template: `{{allowImport "github.com/google/uuid"}}{{if .}}{{range .}}{{allowImport "yaml=gopkg.in/yaml.v3" "log/slog"}}{{end}}{{end}}`,
+			want:     importAllowList{"uuid": "github.com/google/uuid", "yaml": "gopkg.in/yaml.v3", "slog": "log/slog"},
+		},
+		"not a string": {
+			template: `{{allowImport .Foo}}`,
+			err:      "arguments must be strings",
+		},
+		"invalid name": {
+			template: `{{allowImport "1yaml=gopkg.in/yaml.v3"}}`,
+			err:      "must be of the form path or name=path",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tmpl := template.Must(template.New("method").Funcs(funcMap(nil)).Parse(tt.template))
+
+			got, err := templateImports(tmpl)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want.String() {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHermeticImportsPerTemplate(t *testing.T) {
+	// the unary template allows uuid, which the service template may not use.
+	dir := t.TempDir()
+	unary := filepath.Join(dir, "unary.tmpl")
+	service := filepath.Join(dir, "service.tmpl")
+	files := map[string]string{
+		unary: `{{allowImport "github.com/google/uuid"}}
+func (s *{{.StructName}}) New{{.MethodName}}ID() string {
+	return uuid.NewString()
+}`,
+		service: `
+type {{.StructName}} struct{}
+
+func newID() string {
+	return uuid.NewString()
+}`,
+	}
+	for fileName, content := range files {
+		if err := os.WriteFile(fileName, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	generated := generateFiles(t, compileRequest(t, "proto", "hermeticImports=true,unaryMethodTemplate="+unary+",serviceTemplate="+service, "validated/validated.proto"))
+	if !strings.Contains(generated["bookapi/getbook.go"], `"github.com/google/uuid"`) {
+		t.Errorf("uuid was not imported by the method allowing it\n%s", generated["bookapi/getbook.go"])
+	}
+	if strings.Contains(generated["bookapi/service.go"], `"github.com/google/uuid"`) {
+		t.Errorf("uuid was imported by the service which does not allow it\n%s", generated["bookapi/service.go"])
+	}
+}
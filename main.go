@@ -3,14 +3,24 @@ package main
 import (
 	"bytes"
 	"embed"
+	"errors"
 	"flag"
+	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
-	"golang.org/x/tools/imports"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
@@ -26,24 +36,114 @@ const (
 	clientStreamMethodSuffix = "method.client.stream.go.tmpl"
 	bidiStreamMethodSuffix   = "method.bidi.stream.go.tmpl"
 
-	serviceSuffix = "service.go.tmpl"
+	serviceSuffix      = "service.go.tmpl"
+	serverSuffix       = "server.go.tmpl"
+	registerSuffix     = "register.go.tmpl"
+	buildersSuffix     = "builders.go.tmpl"
+	messageSuffix      = "message.go.tmpl"
+	validationSuffix   = "validation.go.tmpl"
+	interceptorsSuffix = "interceptors.go.tmpl"
+	domainSuffix       = "domain.go.tmpl"
+
+	// parts of a method template e.g method.unary.base.go.tmpl.
+	baseTemplatePart = "base"
+	implTemplatePart = "impl"
+	testTemplatePart = "test"
+
+	// files generated once per package.
+	buildersFile     = "zz_generated_builders.go"
+	validationFile   = "zz_generated_validation.go"
+	domainFile       = "zz_generated_domain.go"
+	interceptorsFile = "zz_generated_interceptors.go"
+	messagesFile     = "zz_generated_messages.go"
+
+	generatedHeader = "// Code generated by protoc-gen-go-boilerplate. DO NOT EDIT."
+
+	// headerTemplate partial rendered before the package clause of every generated file.
+	headerTemplate = "header"
 )
 
+// packageFiles the files generated once per package, which the base files of split methods may not replace.
+var packageFiles = []string{buildersFile, validationFile, domainFile, interceptorsFile, messagesFile}
+
 func main() {
+	opts, f := plugin()
+	run(opts, f)
+}
+
+// plugin returns the options parsing the plugin parameters along with the function generating the boilerplate.
+//
+// every call returns a new set of flags, so the plugin may be run many times within a single process.
+func plugin() (protogen.Options, func(gen *protogen.Plugin, params pluginParams, out *output) error) {
 	var flags flag.FlagSet
 	customUnaryMethodTemplate := flags.String("unaryMethodTemplate", "", "custom method template")
 	clientStreamMethodTemplate := flags.String("clientStreamMethodTemplate", "", "custom method template")
 	serverStreamMethodTemplate := flags.String("serverStreamMethodTemplate", "", "custom method template")
 	bidiStreamMethodTemplate := flags.String("bidiStreamMethodTemplate", "", "custom method template")
 	customServiceTemplate := flags.String("serviceTemplate", "", "custom service template")
+	customServerTemplate := flags.String("serverTemplate", "", "custom server template")
+
+	var methodTemplates templateRules
+	flags.Var(&methodTemplates, "methodTemplate", "custom method template for methods matching a pattern e.g List*:templates/list.tmpl, may be repeated")
+
+	generateServerMain := flags.Bool("server", false, "generate a runnable server main package per service")
+	generateTests := flags.Bool("tests", false, "generate test skeletons for every rpc")
+	generateBuilders := flags.Bool("builders", false, "generate fluent builders for the request & response messages of every rpc")
+	generateMessages := flags.Bool("messages", false, "generate functional options, append, map key & oneof setters for every message of the go package of every service")
+	generateValidation := flags.Bool("validation", false, "generate validation functions for the request messages of every rpc from buf.validate & google.api.field_behavior annotations")
+	generateDomain := flags.Bool("domain", false, "generate domain structs mirroring the request & response messages of every rpc, along with mappers to & from their messages")
+	generateValidate := flags.Bool("validate", false, "generate protovalidate interceptors validating every request, added to generated servers")
+	importPath := flags.String("importPath", "", "go import path of the output directory, required for server generation")
+
+	onlyNew := flags.Bool("onlyNew", false, "only generate files which do not already exist within outputRoot")
+	outputRoot := flags.String("outputRoot", "", "directory generated files are written to, relative to the working directory")
+	merge := flags.Bool("merge", false, "only generate stubs for rpcs missing from the existing go files within outputRoot")
+	split := flags.Bool("split", false, "split methods into regenerable base files & user owned implementation files")
+
+	sharedPackage := flags.Bool("sharedPackage", false, "generate every service of a go package into a single package")
+	structName := flags.String("structName", "", "template for the name of the struct implementing a service, defaults to Service or {{.ServiceName}}Service when sharing a package")
 
-	directoryOverride := flags.String("templateDirectory", defaultDir, "custom directory for templates")
+	pathPattern := flags.String("pathPattern", "", "template for the path of every file generated for a service, defaults to {{lower .ServiceName}}/{{lower .Name}}.go")
+	goPackageName := flags.String("goPackageName", "{{.FileGoPkgName}}", "template for the go package name of generated code")
 
-	protogen.Options{
+	directoryOverride := flags.String("templateDirectory", defaultDir, "custom directory for templates, on disk relative to the working directory or embedded")
+	baseDirectory := flags.String("baseTemplateDirectory", defaultDir, "embedded directory providing templates missing from templateDirectory e.g templates/connect")
+
+	var postProcess processorNames
+	flags.Var(&postProcess, "postProcess", "post processor applied to generated files in order, one of gofmt, goimports, gofumpt or none, may be repeated, defaults to gofmt & goimports")
+
+	hermetic := flags.Bool("hermeticImports", false, "resolve imports only from the generated code & allowed imports, without consulting GOPATH or the module cache")
+	allowedImports := importAllowList{}
+	for _, allowed := range defaultAllowedImports {
+		_ = allowedImports.Set(allowed)
+	}
+	flags.Var(allowedImports, "allowImport", "import which may be added when resolving imports hermetically e.g log/slog or yaml=gopkg.in/yaml.v3, may be repeated")
+
+	configFile := flags.String("config", "", "yaml or json file configuring the plugin, plugin parameters take precedence")
+
+	return protogen.Options{
 		ParamFunc: flags.Set,
-	}.Run(func(gen *protogen.Plugin) error {
+	}, func(gen *protogen.Plugin, params pluginParams, out *output) error {
 		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 
+		// options not provided as plugin parameters are populated from the config file.
+		var cfg *Config
+		if *configFile != "" {
+			var err error
+			cfg, err = loadConfig(*configFile)
+			if err != nil {
+				return err
+			}
+
+			if err := cfg.apply(&flags); err != nil {
+				return err
+			}
+
+			if err := cfg.validate(gen); err != nil {
+				return err
+			}
+		}
+
 		// will user `templates` as the default dir.
 		// if populated will override to be the provided directory.
 		directory := defaultDir
@@ -51,15 +151,199 @@ func main() {
 			directory = *directoryOverride
 		}
 
+		layers, err := templateFS(directory, *baseDirectory)
+		if err != nil {
+			return err
+		}
+
+		set, err := parseTemplateSet(layers)
+		if err != nil {
+			return err
+		}
+		templates := newTemplateCache(set)
+
+		// with paths=import generated packages are output to their import path.
+		if *generateServerMain && *importPath == "" && params.paths != pathsImport {
+			return errors.New("server generation requires the importPath option to be set")
+		}
+
+		if (*onlyNew || *split || *merge) && *outputRoot == "" {
+			return errors.New("onlyNew, split & merge require the outputRoot option to be set")
+		}
+
+		// onDisk returns the path of a generated file relative to the working directory.
+		onDisk := func(fileName string) string {
+			return filepath.Join(*outputRoot, params.trimModule(fileName))
+		}
+
+		// existing reports whether a file should be skipped as it has previously been generated.
+		existing := func(fileName string) bool {
+			return *onlyNew && fileExists(onDisk(fileName))
+		}
+
+		structNamePattern := *structName
+		if structNamePattern == "" {
+			structNamePattern = "Service"
+			if *sharedPackage {
+				structNamePattern = "{{.ServiceName}}Service"
+			}
+		}
+
+		filePathPattern := *pathPattern
+		if filePathPattern == "" {
+			filePathPattern = "{{lower .ServiceName}}/{{lower .Name}}.go"
+			if *sharedPackage {
+				filePathPattern = "{{lower .FileGoPkgName}}/{{lower .ServiceName}}_{{lower .Name}}.go"
+			}
+		}
+
+		// unknown post processors are reported prior to rendering any template.
+		if _, err := newPostProcessChain(postProcess, tidyImports); err != nil {
+			return err
+		}
+
+		// goimports returns the post processor tidying the imports of files rendered from the template.
+		// when resolving imports hermetically, the imports allowed by the template may also be added.
+		goimports := func(t *template.Template) postProcessor {
+			if !*hermetic {
+				return tidyImports
+			}
+
+			allowed, err := templateImports(t)
+			if err != nil {
+				return failedPostProcessor(err)
+			}
+			return hermeticImports(allowedImports.with(allowed))
+		}
+
+		// process returns the post processor of files rendered from the template.
+		process := func(t *template.Template) postProcessor {
+			chain, err := newPostProcessChain(postProcess, goimports(t))
+			if err != nil {
+				return failedPostProcessor(err)
+			}
+			return chain
+		}
+
+		// templates are rendered concurrently once every file has been queued.
+		queue := &renderQueue{}
+
+		// generateServer generates a main package serving the services generated within dir.
+		generateServer := func(root string, dir string, services []Service) error {
+			serverFileName := path.Join(root, "cmd", path.Base(dir), "main.go")
+			if !*generateServerMain || existing(serverFileName) {
+				return nil
+			}
+
+			mf := gen.NewGeneratedFile(serverFileName, ".")
+			if err := writeHeader(templates.set, mf); err != nil {
+				return err
+			}
+			mf.P("package main")
+
+			// services may override the server template within the config.
+			serverTemplate := *customServerTemplate
+			for _, s := range services {
+				serverTemplate = firstNonEmpty(cfg.service(s.Service).Templates.Server, serverTemplate)
+			}
+
+			serverT, err := templates.load(serverSuffix, serverTemplate)
+			if err != nil {
+				return err
+			}
+
+			servicePkg := protogen.GoImportPath(path.Join(*importPath, dir))
+			if params.paths == pathsImport {
+				servicePkg = protogen.GoImportPath(dir)
+			}
+			srv := newServer(mf, servicePkg, services)
+			srv.Validate = *generateValidate
+
+			// will tidy the imports of the generated server file.
+			queue.add(mf, serverFileName, serverT, srv, process(serverT))
+			return nil
+		}
+
+		// services grouped by the directory of the package they share, in the order they were generated.
+		shared := map[string][]Service{}
+		sharedRoots := map[string]string{}
+
+		// messages to generate builders & validation functions for grouped by the directory of their package, in the order they were generated.
+		builders := map[string]*Builders{}
+		var builderDirs []string
+		// messages to generate helpers for grouped by the directory of their package, in the order they were generated.
+		messageHelpers := map[string]*MessageHelpers{}
+		var messageDirs []string
+		validations := map[string]*Validation{}
+		var validationDirs []string
+		// the directories of packages to generate protovalidate interceptors for.
+		var interceptorDirs []string
+		// messages to generate domain structs for grouped by the directory of their package, along with their go package.
+		domains := map[string][]*protogen.Message{}
+		var domainDirs []string
+		domainImportPaths := map[string]protogen.GoImportPath{}
+		// the names of the structs implementing the services of each package, which domain structs must not redeclare.
+		domainStructNames := map[string][]string{}
+		dirPkgs := map[string]string{}
+		var packageDirs []string
+
 		for _, file := range gen.Files {
 			if !file.Generate {
 				continue
 			}
 
+			// files are generated relative to the proto file when the paths option has been provided.
+			root := ""
+			if params.paths != "" {
+				root = path.Dir(file.GeneratedFilenamePrefix)
+			}
+
 			for _, service := range file.Services {
-				serviceFileName := strings.ToLower(filepath.Join(service.GoName, "service.go"))
+				serviceConfig := cfg.service(service)
+				serviceOpts := serviceOptions(service)
+				if serviceConfig.Skip || serviceOpts.GetSkip() {
+					continue
+				}
+
+				patternData := Service{
+					ServiceName:    service.GoName,
+					ServerFullName: string(service.Desc.FullName()),
+					FileGoPkgName:  string(file.GoPackageName),
+					Service:        service,
+					File:           file,
+				}
+
+				name, err := executePattern(firstNonEmpty(serviceConfig.StructName, structNamePattern), patternData)
+				if err != nil {
+					return err
+				}
+
+				pkgName, err := executePattern(*goPackageName, patternData)
+				if err != nil {
+					return err
+				}
+
+				// serviceFile returns the path of a file generated for the service e.g ExampleRpc or service.
+				serviceFile := func(name string) (string, error) {
+					fileName, err := executePattern(filePathPattern, Path{
+						ServiceName:   service.GoName,
+						FileGoPkgName: string(file.GoPackageName),
+						Name:          name,
+					})
+					return path.Join(root, fileName), err
+				}
+
+				serviceFileName, err := serviceFile("service")
+				if err != nil {
+					return err
+				}
+				dir := path.Dir(serviceFileName)
+
 				sf := gen.NewGeneratedFile(serviceFileName, ".")
-				sf.P("package " + file.GoPackageName)
+				if err := writeHeader(templates.set, sf); err != nil {
+					return err
+				}
+				sf.P("package " + pkgName)
 
 				// imports
 				ident := sf.QualifiedGoIdent(file.GoDescriptorIdent)
@@ -67,130 +351,562 @@ func main() {
 
 				// generate service struct for go-grpc.
 				// gets alias of file.GoDescriptorIdent
-				pkgIdent := strings.Split(ident, ".")[0]
+				pkgIdent := packageAlias(ident)
 
 				methods := make([]Method, 0, len(service.Methods))
 
+				// the hand written code of the service, only populated when merging.
+				pkg := &existingPackage{methods: map[string]string{}}
+				if *merge {
+					pkg, err = parseExistingPackage(onDisk(dir), name)
+					if err != nil {
+						return err
+					}
+					warnRemovedMethods(pkg, service)
+				}
+
 				for _, method := range service.Methods {
-					fileName := strings.ToLower(filepath.Join(service.GoName, method.GoName+".go"))
-					nf := gen.NewGeneratedFile(fileName, ".")
-					nf.P("package " + file.GoPackageName)
+					methodConfig := serviceConfig.method(method)
+					methodOpts := methodOptions(method)
+					if methodConfig.Skip || methodOpts.GetSkip() {
+						continue
+					}
 
-					m := Method{
-						MethodName:     method.GoName,
-						MethodFullName: string(method.Desc.FullName()),
-						ServiceName:    service.GoName,
-						InputName:      messageImportPath(method.Input, nf),
-						ResponseName:   messageImportPath(method.Output, nf),
-						Ident:          pkgIdent,
-						Method:         method,
-						FileGoPkgName:  string(file.GoPackageName),
+					fileName, err := serviceFile(method.GoName)
+					if err != nil {
+						return err
+					}
+
+					nf := gen.NewGeneratedFile(fileName, ".")
+					if err := writeHeader(templates.set, nf); err != nil {
+						return err
 					}
+					nf.P("package " + pkgName)
+
+					m := newMethod(file, method, pkgIdent, name, nf)
+					m.Validation = *generateValidation
+					m.Domain = *generateDomain
+					methods = append(methods, m)
 
 					// get the appropriate suffix & the override template when applicable.
+					// config method overrides take precedence over proto options, method template rules, config service overrides
+					// & then plugin options.
 					methodSuffix := ""
-					var overrideFile *string
+					overrideFile := ""
+					optionFile := firstNonEmpty(methodOpts.GetTemplate(), methodTemplates.match(method))
 					switch {
 					case method.Desc.IsStreamingServer() && method.Desc.IsStreamingClient():
 						methodSuffix = bidiStreamMethodSuffix
-						overrideFile = bidiStreamMethodTemplate
+						overrideFile = firstNonEmpty(methodConfig.Template, optionFile, serviceConfig.Templates.BidiStream, *bidiStreamMethodTemplate)
 					case method.Desc.IsStreamingServer():
 						methodSuffix = serverStreamMethodSuffix
-						overrideFile = serverStreamMethodTemplate
+						overrideFile = firstNonEmpty(methodConfig.Template, optionFile, serviceConfig.Templates.ServerStream, *serverStreamMethodTemplate)
 					case method.Desc.IsStreamingClient():
 						methodSuffix = clientStreamMethodSuffix
-						overrideFile = clientStreamMethodTemplate
+						overrideFile = firstNonEmpty(methodConfig.Template, optionFile, serviceConfig.Templates.ClientStream, *clientStreamMethodTemplate)
 					default:
 						methodSuffix = unaryMethodSuffix
-						overrideFile = customUnaryMethodTemplate
+						overrideFile = firstNonEmpty(methodConfig.Template, optionFile, serviceConfig.Templates.Unary, *customUnaryMethodTemplate)
 					}
 
-					currentTemplate, err := loadTemplates(directory, methodSuffix, overrideFile)
-					if err != nil {
-						return err
+					// generate a table driven test skeleton for the method.
+					testFileName := testFile(fileName)
+					if *generateTests && !existing(testFileName) && !(*merge && fileExists(onDisk(testFileName))) {
+						tf := gen.NewGeneratedFile(testFileName, ".")
+						if err := writeHeader(templates.set, tf); err != nil {
+							return err
+						}
+						tf.P("package " + pkgName)
+
+						testTemplate, err := templates.load(suffixPart(methodSuffix, testTemplatePart), "")
+						if err != nil {
+							return err
+						}
+
+						// will tidy the imports of the generated test file.
+						queue.add(tf, testFileName, testTemplate, newMethod(file, method, pkgIdent, name, tf), process(testTemplate))
 					}
 
-					buffy := bytes.NewBuffer([]byte{})
-					if err := currentTemplate.Execute(buffy, m); err != nil {
-						return err
+					// split the method into a regenerable base file & a user owned implementation file.
+					if *split {
+						baseFileName := path.Join(path.Dir(fileName), "zz_generated_"+path.Base(fileName))
+						if slices.Contains(packageFiles, path.Base(baseFileName)) {
+							return fmt.Errorf("%s: split base file %s clashes with a file generated for the package, the pathPattern option may be used to rename it", method.Desc.FullName(), baseFileName)
+						}
+						bf := gen.NewGeneratedFile(baseFileName, ".")
+						bf.P(generatedHeader)
+						bf.P()
+						if err := writeHeader(templates.set, bf); err != nil {
+							return err
+						}
+						bf.P("package " + pkgName)
+
+						baseTemplate, err := templates.load(suffixPart(methodSuffix, baseTemplatePart), "")
+						if err != nil {
+							return err
+						}
+
+						// will tidy the imports of the generated base file.
+						queue.add(bf, baseFileName, baseTemplate, newMethod(file, method, pkgIdent, name, bf), process(baseTemplate))
+
+						methodSuffix = suffixPart(methodSuffix, implTemplatePart)
 					}
 
-					nf.P(buffy.String())
-					// will tidy the imports of the generated method file.
-					err = tidyImports(gen, nf, fileName)
+					// will not overwrite a method which may contain hand written code.
+					// implementation files of split methods are only ever generated once.
+					if existing(fileName) || (*split && fileExists(onDisk(fileName))) {
+						nf.Skip()
+						continue
+					}
+
+					// when merging, methods which have already been written are left untouched.
+					implName := m.MethodName
+					if *split {
+						implName = m.HookName
+					}
+					if _, ok := pkg.methods[implName]; ok {
+						nf.Skip()
+						continue
+					}
+
+					currentTemplate, err := templates.load(methodSuffix, overrideFile)
 					if err != nil {
 						return err
 					}
 
-					methods = append(methods, m)
+					// the missing stub is appended to a hand written file which shares its name.
+					if *merge && fileExists(onDisk(fileName)) {
+						queue.add(nf, fileName, currentTemplate, m, mergeInto(onDisk(fileName), goimports(currentTemplate)))
+						continue
+					}
+
+					// will tidy the imports of the generated method file.
+					queue.add(nf, fileName, currentTemplate, m, process(currentTemplate))
 				}
 
-				// todo handle []Service.
 				s := Service{
 					ServiceGoImportPath: file.GoDescriptorIdent.String(),
 					ConnectGoImportPath: connectPath(file).String(),
 					FileGoPkgName:       string(file.GoPackageName),
+					GoPackageName:       pkgName,
 					ServiceName:         service.GoName,
+					StructName:          name,
 					ServerFullName:      string(service.Desc.FullName()),
+					LeadingComments:     formatComments(service.Comments.Leading),
+					TrailingComments:    formatComments(service.Comments.Trailing),
+					Deprecated:          service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated(),
+					Values:              serviceOpts.GetValues(),
 					Methods:             methods,
 					Service:             service,
+					File:                file,
 					Ident:               pkgIdent,
 				}
 
-				serviceT, err := loadTemplates(directory, serviceSuffix, customServiceTemplate)
-				if err != nil {
+				if existing(serviceFileName) || pkg.hasService {
+					sf.Skip()
+				} else {
+					serviceTemplate := firstNonEmpty(serviceConfig.Templates.Service, serviceOpts.GetTemplate(), *customServiceTemplate)
+					serviceT, err := templates.load(serviceSuffix, serviceTemplate)
+					if err != nil {
+						return err
+					}
+
+					// will tidy the imports of the generated service file.
+					queue.add(sf, serviceFileName, serviceT, s, process(serviceT))
+				}
+
+				// generate an in memory test harness for the service.
+				serviceTestFileName := testFile(serviceFileName)
+				if *generateTests && !existing(serviceTestFileName) && !(*merge && fileExists(onDisk(serviceTestFileName))) {
+					tf := gen.NewGeneratedFile(serviceTestFileName, ".")
+					if err := writeHeader(templates.set, tf); err != nil {
+						return err
+					}
+					tf.P("package " + pkgName)
+
+					// methods are qualified relative to the test file.
+					ts := s
+					ts.Methods = make([]Method, 0, len(service.Methods))
+					for _, method := range service.Methods {
+						if serviceConfig.method(method).Skip || methodOptions(method).GetSkip() {
+							continue
+						}
+						ts.Methods = append(ts.Methods, newMethod(file, method, pkgIdent, name, tf))
+					}
+
+					serviceTestT, err := templates.load(suffixPart(serviceSuffix, testTemplatePart), "")
+					if err != nil {
+						return err
+					}
+
+					// will tidy the imports of the generated service test file.
+					queue.add(tf, serviceTestFileName, serviceTestT, ts, process(serviceTestT))
+				}
+
+				// builders are generated once per package as services sharing a package may share messages.
+				if *generateBuilders {
+					if _, ok := builders[dir]; !ok {
+						builderDirs = append(builderDirs, dir)
+						dirPkgs[dir] = pkgName
+						builders[dir] = &Builders{}
+					}
+					builders[dir].Messages = builderMessages(builders[dir].Messages, methods, file.GoImportPath)
+				}
+
+				// message helpers are generated once per package for the same reason.
+				if *generateMessages {
+					if _, ok := messageHelpers[dir]; !ok {
+						messageDirs = append(messageDirs, dir)
+						dirPkgs[dir] = pkgName
+						messageHelpers[dir] = &MessageHelpers{}
+					}
+					messageHelpers[dir].Messages = helperMessages(messageHelpers[dir].Messages, gen.Files, file.GoImportPath)
+				}
+
+				// validation functions are generated once per package for the same reason.
+				if *generateValidation {
+					if _, ok := validations[dir]; !ok {
+						validationDirs = append(validationDirs, dir)
+						dirPkgs[dir] = pkgName
+						validations[dir] = &Validation{}
+					}
+					validations[dir].Messages, err = validationMessages(validations[dir].Messages, methods, file.GoImportPath)
+					if err != nil {
+						return err
+					}
+				}
+
+				// domain structs are generated once per package for the same reason as builders.
+				if *generateDomain {
+					if _, ok := domains[dir]; !ok {
+						domainDirs = append(domainDirs, dir)
+						dirPkgs[dir] = pkgName
+						domainImportPaths[dir] = file.GoImportPath
+					}
+					domains[dir] = builderMessages(domains[dir], methods, file.GoImportPath)
+					domainStructNames[dir] = append(domainStructNames[dir], name)
+				}
+
+				// interceptors are generated once per package.
+				if *generateValidate && !slices.Contains(interceptorDirs, dir) {
+					interceptorDirs = append(interceptorDirs, dir)
+					dirPkgs[dir] = pkgName
+				}
+
+				// services sharing a package are served together once every file has been generated.
+				if *sharedPackage {
+					if _, ok := shared[dir]; !ok {
+						packageDirs = append(packageDirs, dir)
+						sharedRoots[dir] = root
+					}
+					shared[dir] = append(shared[dir], s)
+					continue
+				}
+
+				if err := generateServer(root, dir, []Service{s}); err != nil {
 					return err
 				}
+			}
+		}
+
+		for _, dir := range builderDirs {
+			// builders are always regenerated as they are not intended to be edited.
+			buildersFileName := path.Join(dir, buildersFile)
+			bf := gen.NewGeneratedFile(buildersFileName, ".")
+			bf.P(generatedHeader)
+			bf.P()
+			if err := writeHeader(templates.set, bf); err != nil {
+				return err
+			}
+			bf.P("package " + dirPkgs[dir])
+
+			buildersT, err := templates.load(buildersSuffix, "")
+			if err != nil {
+				return err
+			}
+
+			// will tidy the imports of the generated builders file.
+			queue.add(bf, buildersFileName, buildersT, builders[dir], process(buildersT))
+		}
+
+		for _, dir := range messageDirs {
+			// message helpers are always regenerated as they are not intended to be edited.
+			messagesFileName := path.Join(dir, messagesFile)
+			msf := gen.NewGeneratedFile(messagesFileName, ".")
+			msf.P(generatedHeader)
+			msf.P()
+			if err := writeHeader(templates.set, msf); err != nil {
+				return err
+			}
+			msf.P("package " + dirPkgs[dir])
+
+			messageT, err := templates.load(messageSuffix, "")
+			if err != nil {
+				return err
+			}
+
+			// will tidy the imports of the generated message helpers file.
+			queue.add(msf, messagesFileName, messageT, messageHelpers[dir], process(messageT))
+		}
+
+		for _, dir := range validationDirs {
+			// validation functions are always regenerated as they are derived from the proto annotations.
+			validationFileName := path.Join(dir, validationFile)
+			vf := gen.NewGeneratedFile(validationFileName, ".")
+			vf.P(generatedHeader)
+			vf.P()
+			if err := writeHeader(templates.set, vf); err != nil {
+				return err
+			}
+			vf.P("package " + dirPkgs[dir])
+
+			validationT, err := templates.load(validationSuffix, "")
+			if err != nil {
+				return err
+			}
+
+			// will tidy the imports of the generated validation file.
+			queue.add(vf, validationFileName, validationT, validations[dir], process(validationT))
+		}
+
+		for _, dir := range domainDirs {
+			// domain structs are always regenerated as they mirror the messages.
+			domainFileName := path.Join(dir, domainFile)
+			df := gen.NewGeneratedFile(domainFileName, ".")
+			df.P(generatedHeader)
+			df.P()
+			if err := writeHeader(templates.set, df); err != nil {
+				return err
+			}
+			df.P("package " + dirPkgs[dir])
+
+			domainT, err := templates.load(domainSuffix, "")
+			if err != nil {
+				return err
+			}
+
+			domain := newDomain(df, domains[dir], domainImportPaths[dir])
+			if err := checkDomainNames(domain, domainStructNames[dir]); err != nil {
+				return err
+			}
+
+			// will tidy the imports of the generated domain file.
+			queue.add(df, domainFileName, domainT, domain, process(domainT))
+		}
+
+		for _, dir := range interceptorDirs {
+			// interceptors are always regenerated as they are not intended to be edited.
+			interceptorsFileName := path.Join(dir, interceptorsFile)
+			inf := gen.NewGeneratedFile(interceptorsFileName, ".")
+			inf.P(generatedHeader)
+			inf.P()
+			if err := writeHeader(templates.set, inf); err != nil {
+				return err
+			}
+			inf.P("package " + dirPkgs[dir])
+
+			interceptorsT, err := templates.load(interceptorsSuffix, "")
+			if err != nil {
+				return err
+			}
+
+			// will tidy the imports of the generated interceptors file.
+			queue.add(inf, interceptorsFileName, interceptorsT, nil, process(interceptorsT))
+		}
 
-				buffy := bytes.NewBuffer([]byte{})
-				if err := serviceT.Execute(buffy, s); err != nil {
+		for _, dir := range packageDirs {
+			services := shared[dir]
+
+			// generate a single function registering every service of the package.
+			registerFileName := path.Join(dir, "register.go")
+			if !existing(registerFileName) {
+				rf := gen.NewGeneratedFile(registerFileName, ".")
+				if err := writeHeader(templates.set, rf); err != nil {
 					return err
 				}
-				sf.P(buffy.String())
+				rf.P("package " + services[0].GoPackageName)
 
-				// will tidy the imports of the generated service file.
-				err = tidyImports(gen, sf, serviceFileName)
+				registerT, err := templates.load(registerSuffix, "")
 				if err != nil {
 					return err
 				}
+
+				// will tidy the imports of the generated register file.
+				queue.add(rf, registerFileName, registerT, newServer(rf, ".", services), process(registerT))
+			}
+
+			if err := generateServer(sharedRoots[dir], dir, services); err != nil {
+				return err
 			}
 		}
+
+		return queue.run(out)
+	}
+}
+
+// writeHeader renders the header partial of the template set to the file, writing nothing when it is empty.
+func writeHeader(templates *template.Template, f *protogen.GeneratedFile) error {
+	t := templates.Lookup(headerTemplate)
+	if t == nil {
 		return nil
-	})
+	}
+
+	buffy := bytes.NewBuffer([]byte{})
+	if err := t.Funcs(funcMap(f)).Execute(buffy, nil); err != nil {
+		return err
+	}
+
+	header := strings.TrimSpace(buffy.String())
+	if header == "" {
+		return nil
+	}
+
+	f.P(header)
+	f.P()
+	return nil
 }
 
-func loadTemplates(dir string, suffix string, override *string) (*template.Template, error) {
-	if override != nil && len(*override) > 0 {
-		return template.ParseFiles(*override)
+// executePattern executes a template provided as an option e.g structName.
+func executePattern(pattern string, data any) (string, error) {
+	t, err := template.New("pattern").Funcs(funcMap(nil)).Parse(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	buffy := bytes.NewBuffer([]byte{})
+	if err := t.Execute(buffy, data); err != nil {
+		return "", err
 	}
+	return buffy.String(), nil
+}
 
-	return template.ParseFS(embeddedTemplates, filepath.Join(dir, suffix))
+// firstNonEmpty returns the first value which is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
 }
 
-// tidyImports will format imports into one import group and will remove any unused imports.
+// testFile returns the name of the test file for a go file e.g foo_test.go for foo.go.
+func testFile(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_test.go"
+}
+
+// render executes the template with its functions bound to the generated file & writes the result to the file.
 //
-// does this via skipping the provided generatedFile & recreating an identical file with the same content but formatted.
-func tidyImports(gen *protogen.Plugin, generatedFile *protogen.GeneratedFile, fileName string) error {
-	bites, err := generatedFile.Content()
+// the template is cloned so the same template can be rendered to many files concurrently.
+func render(t *template.Template, f *protogen.GeneratedFile, data any) error {
+	t, err := t.Clone()
 	if err != nil {
 		return err
 	}
 
-	bites, err = format.Source(bites)
-	if err != nil {
+	buffy := bytes.NewBuffer([]byte{})
+	if err := t.Funcs(funcMap(f)).Execute(buffy, data); err != nil {
 		return err
 	}
 
-	bites, err = imports.Process(fileName, bites, nil) // opt nil will result in default behaviour.
+	f.P(buffy.String())
+	return nil
+}
+
+// suffixPart returns the template suffix for a part of a method e.g method.unary.base.go.tmpl.
+func suffixPart(suffix string, part string) string {
+	return strings.TrimSuffix(suffix, ".go.tmpl") + "." + part + ".go.tmpl"
+}
+
+// warnings where warnings are written, stderr is surfaced by protoc & buf.
+var warnings io.Writer = os.Stderr
+
+// warnf writes a warning to warnings.
+func warnf(format string, args ...any) {
+	fmt.Fprintf(warnings, "protoc-gen-go-boilerplate: warning: "+format+"\n", args...)
+}
+
+// dedupeImports removes imports declared by both a template & protogen.
+//
+// an import is a duplicate when its path has previously been imported under the same name,
+// an unnamed import is considered to share the name of the last element of its path.
+func dedupeImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	generatedFile.Skip()
 
-	// will recreate an identical file but with the imports in order.
-	newFile := gen.NewGeneratedFile(fileName, ".")
-	newFile.P(string(bites))
-	return nil
+	importName := func(spec *ast.ImportSpec, importPath string) string {
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return path.Base(importPath)
+	}
+
+	// duplicates are deleted once every import has been inspected, as deleting modifies f.Imports.
+	seen := map[string]bool{}
+	duplicates := map[*ast.ImportSpec]bool{}
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		key := importPath + " " + importName(spec, importPath)
+		if !seen[key] {
+			seen[key] = true
+			continue
+		}
+		duplicates[spec] = true
+	}
+
+	if len(duplicates) == 0 {
+		return src, nil
+	}
+
+	// the specs are removed from their declarations, astutil.DeleteNamedImport would also delete the first import of the path.
+	decls := f.Decls[:0]
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			decls = append(decls, decl)
+			continue
+		}
+
+		specs := gen.Specs[:0]
+		lastLine := fset.Position(gen.Lparen).Line
+		for _, spec := range gen.Specs {
+			line := fset.Position(spec.Pos()).Line
+			if !duplicates[spec.(*ast.ImportSpec)] {
+				specs = append(specs, spec)
+				lastLine = fset.Position(spec.End()).Line
+				continue
+			}
+
+			// the line of the removed spec is merged with the next, as astutil does, unless it was preceded by a blank line.
+			if gen.Rparen.IsValid() && line-lastLine == 1 && line != fset.File(gen.Rparen).LineCount() {
+				fset.File(gen.Rparen).MergeLine(line)
+			}
+		}
+		gen.Specs = specs
+		if len(specs) > 0 {
+			decls = append(decls, gen)
+		}
+	}
+	f.Decls = decls
+
+	buf := bytes.NewBuffer([]byte{})
+	if err := format.Node(buf, fset, f); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fileExists reports whether the file exists.
+func fileExists(fileName string) bool {
+	_, err := os.Stat(fileName)
+	return err == nil
 }
 
 // assumption this is always going to be in a different package.
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestDedupeImports(t *testing.T) {
+	tests := map[string]struct {
+		src  string
+		want []string
+	}{
+		"adjacent duplicates": {
+			src: `package foo
+
+import (
+	"context"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc"
+)
+`,
+			want: []string{"context", "google.golang.org/grpc", "google.golang.org/protobuf/proto"},
+		},
+		"named duplicates": {
+			src: `package foo
+
+import (
+	connect "connectrpc.com/connect"
+	"connectrpc.com/connect"
+	foo "example.com/foo"
+)
+
+import "example.com/foo"
+`,
+			want: []string{"connectrpc.com/connect", "example.com/foo"},
+		},
+		"separate declarations": {
+			src: `package foo
+
+import "context"
+
+import "context"
+`,
+			want: []string{"context"},
+		},
+		"no duplicates": {
+			src: `package foo
+
+import (
+	"context"
+	"errors"
+)
+`,
+			want: []string{"context", "errors"},
+		},
+	}
+
+	// imports are sorted as gofmt does.
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := dedupeImports([]byte(tt.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := parser.ParseFile(token.NewFileSet(), "", got, parser.ImportsOnly)
+			if err != nil {
+				t.Fatalf("%v\n%s", err, got)
+			}
+
+			var paths []string
+			for _, spec := range f.Imports {
+				importPath, _ := strconv.Unquote(spec.Path.Value)
+				paths = append(paths, importPath)
+			}
+			if !slices.Equal(paths, tt.want) {
+				t.Fatalf("got imports %v, want %v\n%s", paths, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code using the go command")
+	}
+
+	// the methods of temp.proto split into base & implementation files.
+	methods := []string{"exampleapi/examplerpc.go", "exampleapi/exampleanyrpc.go", "exampleapi/exampleclientstream.go",
+		"exampleapi/exampleserverstream.go", "exampleapi/examplebidistream.go", "examplesecondaryapi/examplerpc.go"}
+	base := func(fileName string) string {
+		return filepath.ToSlash(filepath.Join(filepath.Dir(fileName), "zz_generated_"+filepath.Base(fileName)))
+	}
+
+	for _, templateDirectory := range []string{"templates", "templates/connect"} {
+		t.Run(templateDirectory, func(t *testing.T) {
+			dir := tempDir(t)
+			parameter := "split=true,outputRoot=" + dir + ",templateDirectory=" + templateDirectory
+
+			first := generateFiles(t, compileRequest(t, "proto", parameter, "temp/temp.proto"))
+			for _, fileName := range methods {
+				for _, name := range []string{fileName, base(fileName)} {
+					if _, ok := first[name]; !ok {
+						t.Fatalf("first run did not generate %s", name)
+					}
+				}
+			}
+
+			// the implementation files are then edited by hand.
+			handWritten := "\n// hand written.\n"
+			for _, fileName := range methods {
+				first[fileName] += handWritten
+			}
+			writeFiles(t, dir, first)
+
+			second := generateFiles(t, compileRequest(t, "proto", parameter, "temp/temp.proto"))
+			for _, fileName := range methods {
+				if _, ok := second[base(fileName)]; !ok {
+					t.Errorf("second run did not regenerate %s", base(fileName))
+				}
+				if _, ok := second[fileName]; ok {
+					t.Errorf("second run regenerated the implementation file %s", fileName)
+				}
+			}
+			writeFiles(t, dir, second)
+
+			for _, fileName := range methods {
+				content, err := os.ReadFile(filepath.Join(dir, fileName))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !strings.HasSuffix(string(content), handWritten) {
+					t.Errorf("%s lost its hand written code\n%s", fileName, content)
+				}
+			}
+
+			out, err := exec.Command("go", "vet", "./"+dir+"/...").CombinedOutput()
+			if err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
+
+func TestOnlyNew(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code using the go command")
+	}
+
+	dir := tempDir(t)
+	parameter := "onlyNew=true,tests=true,outputRoot=" + dir
+
+	// the files of a previous run which have since been edited by hand, before the remaining rpcs were added.
+	previous := []string{"exampleapi/service.go", "exampleapi/service_test.go", "exampleapi/examplerpc.go", "exampleapi/examplerpc_test.go"}
+	handWritten := "\n// hand written.\n"
+	all := generateFiles(t, compileRequest(t, "proto", parameter, "temp/temp.proto"))
+	existing := map[string]string{}
+	for _, fileName := range previous {
+		existing[fileName] = all[fileName] + handWritten
+	}
+	writeFiles(t, dir, existing)
+
+	generated := generateFiles(t, compileRequest(t, "proto", parameter, "temp/temp.proto"))
+	for _, fileName := range previous {
+		if _, ok := generated[fileName]; ok {
+			t.Errorf("%s already exists but was generated", fileName)
+		}
+	}
+	for _, fileName := range []string{"exampleapi/exampleanyrpc.go", "exampleapi/exampleanyrpc_test.go", "exampleapi/examplebidistream.go",
+		"examplesecondaryapi/service.go", "examplesecondaryapi/examplerpc.go"} {
+		if _, ok := generated[fileName]; !ok {
+			t.Errorf("new file %s was not generated", fileName)
+		}
+	}
+	writeFiles(t, dir, generated)
+
+	for _, fileName := range previous {
+		content, err := os.ReadFile(filepath.Join(dir, fileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasSuffix(string(content), handWritten) {
+			t.Errorf("%s lost its hand written code\n%s", fileName, content)
+		}
+	}
+
+	out, err := exec.Command("go", "vet", "./"+dir+"/...").CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+}
+
+func TestOutputPaths(t *testing.T) {
+	// the service of names.proto has the rpcs Type & Go, its go package is gen/names.
+	const names = goPackagePrefix + "/names"
+	tests := map[string]struct {
+		parameter string
+		// want the package clause of every generated file by its name.
+		want map[string]string
+		// server the import path of the service package imported by the server main package.
+		server string
+		err    string
+	}{
+		"default": {
+			want: map[string]string{"namesapi/service.go": "names", "namesapi/type.go": "names", "namesapi/go.go": "names"},
+		},
+		"paths=import": {
+			parameter: "paths=import",
+			want: map[string]string{
+				names + "/namesapi/service.go": "names", names + "/namesapi/type.go": "names", names + "/namesapi/go.go": "names",
+			},
+		},
+		"paths=import module": {
+			parameter: "paths=import,module=" + modulePath,
+			want:      map[string]string{"gen/names/namesapi/service.go": "names", "gen/names/namesapi/type.go": "names", "gen/names/namesapi/go.go": "names"},
+		},
+		"module mismatch": {
+			parameter: "paths=import,module=example.com/other",
+			err:       `generated file does not match prefix "example.com/other"`,
+		},
+		"paths=source_relative": {
+			parameter: "paths=source_relative",
+			want:      map[string]string{"names/namesapi/service.go": "names", "names/namesapi/type.go": "names", "names/namesapi/go.go": "names"},
+		},
+		"pathPattern & goPackageName": {
+			parameter: "pathPattern={{lower .FileGoPkgName}}/{{lower .ServiceName}}_{{lower .Name}}.go,goPackageName={{.FileGoPkgName}}svc",
+			want:      map[string]string{"names/namesapi_service.go": "namessvc", "names/namesapi_type.go": "namessvc", "names/namesapi_go.go": "namessvc"},
+		},
+		"server importPath": {
+			parameter: "server=true,importPath=example.com/m",
+			want: map[string]string{
+				"namesapi/service.go": "names", "namesapi/type.go": "names", "namesapi/go.go": "names", "cmd/namesapi/main.go": "main",
+			},
+			server: "example.com/m/namesapi",
+		},
+		"server paths=import module": {
+			parameter: "server=true,paths=import,module=" + modulePath,
+			want: map[string]string{
+				"gen/names/namesapi/service.go": "names", "gen/names/namesapi/type.go": "names", "gen/names/namesapi/go.go": "names",
+				"gen/names/cmd/namesapi/main.go": "main",
+			},
+			server: names + "/namesapi",
+		},
+		"server without importPath": {
+			parameter: "server=true",
+			err:       "server generation requires the importPath option to be set",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts, f := plugin()
+			resp, err := generate(opts, f, compileRequest(t, "testdata/split", tt.parameter))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.err != "" {
+				if !strings.Contains(resp.GetError(), tt.err) {
+					t.Fatalf("got error %q, want %q", resp.GetError(), tt.err)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatal(resp.GetError())
+			}
+
+			got := map[string]string{}
+			for _, file := range resp.File {
+				parsed, err := parser.ParseFile(token.NewFileSet(), file.GetName(), file.GetContent(), parser.ImportsOnly)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got[file.GetName()] = parsed.Name.Name
+
+				if parsed.Name.Name != "main" {
+					continue
+				}
+				var imports []string
+				for _, spec := range parsed.Imports {
+					importPath, _ := strconv.Unquote(spec.Path.Value)
+					imports = append(imports, importPath)
+				}
+				if !slices.Contains(imports, tt.server) {
+					t.Errorf("%s does not import %s, imports %v", file.GetName(), tt.server, imports)
+				}
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("got files %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
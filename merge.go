@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// existingPackage declarations of a service within a previously generated package.
+type existingPackage struct {
+	// structName the name of the struct implementing the service.
+	structName string
+	// hasService whether the service struct has been declared.
+	hasService bool
+	// methods maps the name of each method declared on the service struct to the file it is declared in.
+	methods map[string]string
+}
+
+// parseExistingPackage parses the hand written go files within dir for declarations of the service struct.
+//
+// generated base files & tests are ignored, a missing directory results in an empty package.
+func parseExistingPackage(dir string, structName string) (*existingPackage, error) {
+	pkg := &existingPackage{structName: structName, methods: map[string]string{}}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return pkg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasPrefix(name, "zz_generated_") {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == structName {
+						pkg.hasService = true
+					}
+				}
+			case *ast.FuncDecl:
+				if receiverName(decl) == structName {
+					pkg.methods[decl.Name.Name] = path
+				}
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+// receiverName returns the type name of a method receiver, empty for functions.
+func receiverName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return ""
+	}
+
+	expr := decl.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// warnRemovedMethods writes a warning for each exported method of the service struct which is no longer an rpc of the service.
+func warnRemovedMethods(pkg *existingPackage, service *protogen.Service) {
+	rpcs := make(map[string]bool, len(service.Methods))
+	for _, method := range service.Methods {
+		rpcs[method.GoName] = true
+	}
+
+	names := make([]string, 0, len(pkg.methods))
+	for name := range pkg.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if token.IsExported(name) && !rpcs[name] {
+			warnf("%s: %s.%s is not an rpc of %s", pkg.methods[name], pkg.structName, name, service.Desc.FullName())
+		}
+	}
+}
+
+// mergeInto returns a post processor appending the declarations of the rendered stub to the existing file at path.
+//
+// the existing file's comments & formatting are preserved, imports required by the stub are added by goimports
+// e.g tidyImports or hermeticImports.
+func mergeInto(path string, goimports postProcessor) postProcessor {
+	return func(fileName string, stub []byte) ([]byte, error) {
+		existing, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		bites, err := appendDecls(existing, stub)
+		if err != nil {
+			return nil, err
+		}
+
+		return goimports(fileName, bites)
+	}
+}
+
+// appendDecls appends all declarations following the imports of src to dst, merging the imports of both files.
+func appendDecls(dst []byte, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	srcFile, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// declarations start after the package clause & any import declarations.
+	offset := fset.Position(srcFile.Name.End()).Offset
+	for _, decl := range srcFile.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			offset = fset.Position(gd.End()).Offset
+		}
+	}
+
+	merged := append(bytes.TrimRight(dst, "\n"), '\n', '\n')
+	merged = append(merged, bytes.TrimLeft(src[offset:], "\n")...)
+
+	fset = token.NewFileSet()
+	dstFile, err := parser.ParseFile(fset, "", merged, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := make(map[string]bool, len(dstFile.Imports))
+	for _, imp := range dstFile.Imports {
+		imported[imp.Path.Value] = true
+	}
+
+	for _, imp := range srcFile.Imports {
+		if imported[imp.Path.Value] {
+			continue
+		}
+
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name := ""
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		astutil.AddNamedImport(fset, dstFile, name, importPath)
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	if err := format.Node(buf, fset, dstFile); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMergeIntoHermeticImports(t *testing.T) {
+	existing := filepath.Join(t.TempDir(), "getbook.go")
+	content := `package bookapi
+
+// GetBook hand written.
+func (s *Service) GetBook() {}
+`
+	if err := os.WriteFile(existing, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	stub := `package bookapi
+
+import "context"
+
+func (s *Service) CreateBook(ctx context.Context) string {
+	return uuid.NewString()
+}
+`
+
+	// imports of the stub are only resolved from the allow list.
+	merge := mergeInto(existing, hermeticImports(importAllowList{"uuid": "github.com/google/uuid"}))
+	got, err := merge("bookapi/getbook.go", []byte(stub))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"// GetBook hand written.", "func (s *Service) CreateBook(", `"context"`, `"github.com/google/uuid"`} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("merged file does not contain %q\n%s", want, got)
+		}
+	}
+}
+
+func TestParseExistingPackage(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"handwritten.go": `package bookapi
+
+type Service struct{}
+
+func (s *Service) GetBook() {}
+
+func (s Service) ListBooks() {}
+
+func (o *other) CreateBook() {}
+
+func DeleteBook() {}
+`,
+		"other.go":                "package bookapi\n\ntype other struct{}\n\nfunc (s *Service) UpdateBook() {}\n",
+		"zz_generated_getbook.go": "package bookapi\n\nfunc (s *Service) ImportBooks() {}\n",
+		"getbook_test.go":         "package bookapi\n\nfunc (s *Service) ExportBooks() {}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pkg, err := parseExistingPackage(dir, "Service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pkg.hasService {
+		t.Error("the Service struct was not found")
+	}
+
+	// only methods of the service struct declared within hand written files are found.
+	want := map[string]string{
+		"GetBook":    filepath.Join(dir, "handwritten.go"),
+		"ListBooks":  filepath.Join(dir, "handwritten.go"),
+		"UpdateBook": filepath.Join(dir, "other.go"),
+	}
+	if !maps.Equal(pkg.methods, want) {
+		t.Errorf("got methods %v, want %v", pkg.methods, want)
+	}
+
+	missing, err := parseExistingPackage(filepath.Join(dir, "missing"), "Service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing.hasService || len(missing.methods) != 0 {
+		t.Errorf("a missing directory should result in an empty package, got %+v", missing)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code using the go command")
+	}
+
+	dir := tempDir(t)
+	parameter := "merge=true,outputRoot=" + dir
+	all := generateFiles(t, compileRequest(t, "proto", parameter, "temp/temp.proto"))
+
+	// hand written code of ExampleAPI, written prior to the remaining rpcs being added.
+	existing := map[string]string{
+		"exampleapi/service.go": all["exampleapi/service.go"],
+		// ExampleRpc is implemented within a file of another name, along with a method which is no longer an rpc.
+		"exampleapi/handwritten.go": all["exampleapi/examplerpc.go"] + "\n// Removed is no longer an rpc.\nfunc (s *Service) Removed() {}\n",
+		// ExampleAnyRpc is implemented by another type.
+		"exampleapi/other.go": "package temp\n\ntype other struct{}\n\nfunc (o *other) ExampleAnyRpc() {}\n",
+		// a hand written file sharing its name with the stub of ExampleClientStream.
+		"exampleapi/exampleclientstream.go": "package temp\n\n// helper is hand written.\nfunc helper() {}\n",
+	}
+	writeFiles(t, dir, existing)
+
+	var warned bytes.Buffer
+	warnings = &warned
+	t.Cleanup(func() { warnings = os.Stderr })
+
+	generated := generateFiles(t, compileRequest(t, "proto", parameter, "temp/temp.proto"))
+	for _, fileName := range []string{"exampleapi/service.go", "exampleapi/examplerpc.go", "exampleapi/handwritten.go", "exampleapi/other.go"} {
+		if _, ok := generated[fileName]; ok {
+			t.Errorf("%s was generated although the service already implements it", fileName)
+		}
+	}
+	for _, fileName := range []string{"exampleapi/exampleanyrpc.go", "exampleapi/exampleserverstream.go", "exampleapi/examplebidistream.go"} {
+		if _, ok := generated[fileName]; !ok {
+			t.Errorf("%s was not generated", fileName)
+		}
+	}
+
+	merged := generated["exampleapi/exampleclientstream.go"]
+	for _, want := range []string{"// helper is hand written.\nfunc helper() {}", "func (s *Service) ExampleClientStream("} {
+		if !strings.Contains(merged, want) {
+			t.Errorf("exampleapi/exampleclientstream.go does not contain %q\n%s", want, merged)
+		}
+	}
+
+	want := filepath.Join(dir, "exampleapi", "handwritten.go") + ": Service.Removed is not an rpc of proto.ExampleAPI"
+	if !strings.Contains(warned.String(), want) {
+		t.Errorf("got warnings %q, want %q", warned.String(), want)
+	}
+
+	writeFiles(t, dir, generated)
+	out, err := exec.Command("go", "vet", "./"+dir+"/...").CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+}
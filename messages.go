@@ -0,0 +1,45 @@
+package main
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageHelpers data used to generate helper functions for messages.
+type MessageHelpers struct {
+	// Messages the messages to generate helpers for, nested messages follow the message they are declared within.
+	Messages []*protogen.Message
+}
+
+// helperMessages appends every message declared within the generated files of the go package importPath to messages,
+// including nested messages. messages which have previously been appended are skipped.
+func helperMessages(messages []*protogen.Message, files []*protogen.File, importPath protogen.GoImportPath) []*protogen.Message {
+	seen := map[protoreflect.FullName]bool{}
+	for _, message := range messages {
+		seen[message.Desc.FullName()] = true
+	}
+
+	var visit func(message *protogen.Message)
+	visit = func(message *protogen.Message) {
+		// map entries are set via their map field rather than helpers.
+		if seen[message.Desc.FullName()] || message.Desc.IsMapEntry() {
+			return
+		}
+		seen[message.Desc.FullName()] = true
+		messages = append(messages, message)
+
+		for _, nested := range message.Messages {
+			visit(nested)
+		}
+	}
+
+	for _, file := range files {
+		if !file.Generate || file.GoImportPath != importPath {
+			continue
+		}
+		for _, message := range file.Messages {
+			visit(message)
+		}
+	}
+	return messages
+}
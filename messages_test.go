@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os/exec"
+	"testing"
+)
+
+// messageHelpersTest exercises the message helpers generated for temp.proto.
+const messageHelpersTest = `package temp
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	temp "github.com/lcmaguire/protoc-gen-go-boilerplate/gen/temp"
+)
+
+func TestMessageHelpers(t *testing.T) {
+	msg := NewExampleMessage(
+		WithExampleName("name"),
+		WithExampleTags("a"),
+		WithExampleExtraComments("comments"),
+		WithExampleFooMap(map[string]*temp.Foo{"one": {Count: 1}}),
+		WithExampleAbc("abc"),
+	)
+	AppendExampleTags(msg, "b", "c")
+	SetExampleFooMapKey(msg, "two", NewFooMessage(WithFooCount(2)))
+	SetExampleFar(msg, NewExample_FarMessage(WithExample_FarActive(true)))
+
+	want := &temp.Example{
+		Name:          "name",
+		Tags:          []string{"a", "b", "c"},
+		ExtraComments: proto.String("comments"),
+		FooMap:        map[string]*temp.Foo{"one": {Count: 1}, "two": {Count: 2}},
+		AbcOneof:      &temp.Example_Far_{Far: &temp.Example_Far{Active: true}},
+	}
+	if !proto.Equal(msg, want) {
+		t.Fatalf("got %v, want %v", msg, want)
+	}
+
+	empty := &temp.Example{}
+	SetExampleFooMapKey(empty, "key", nil)
+	if _, ok := empty.FooMap["key"]; !ok {
+		t.Fatal("the key of a nil map was not set")
+	}
+}
+`
+
+func TestMessageHelpers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the generated code using the go command")
+	}
+
+	for _, templateDirectory := range []string{"templates", "templates/connect"} {
+		t.Run(templateDirectory, func(t *testing.T) {
+			dir := tempDir(t)
+			generated := generateFiles(t, compileRequest(t, "proto", "messages=true,templateDirectory="+templateDirectory, "temp/temp.proto"))
+			if _, ok := generated["exampleapi/zz_generated_messages.go"]; !ok {
+				t.Fatalf("exampleapi/zz_generated_messages.go was not generated")
+			}
+			generated["exampleapi/messages_test.go"] = messageHelpersTest
+			writeFiles(t, dir, generated)
+
+			out, err := exec.Command("go", "test", "./"+dir+"/exampleapi").CombinedOutput()
+			if err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
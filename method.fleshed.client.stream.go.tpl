@@ -0,0 +1,103 @@
+import (
+	"context"
+	"errors"
+	"io"
+)
+{{- /* messages of the proto go package are mapped to the domain structs generated by the domain option, when enabled. */}}
+{{- $in := printf "*%s" .InputName}}
+{{- if and .Domain (eq .Method.Input.GoIdent.GoImportPath .File.GoImportPath)}}{{$in = printf "*%s" .Method.Input.GoIdent.GoName}}{{end}}
+{{- $out := printf "*%s" .ResponseName}}
+{{- if and .Domain (eq .Method.Output.GoIdent.GoImportPath .File.GoImportPath)}}{{$out = printf "*%s" .Method.Output.GoIdent.GoName}}{{end}}
+
+// {{.StructName}}{{.MethodName}}Stages the stages of {{.MethodFullName}}, each stage is typed by the result of the previous stage.
+type {{.StructName}}{{.MethodName}}Stages interface {
+	// Validate rejects an invalid request, every streamed request is validated.
+	Validate(ctx context.Context, in *{{.InputName}}) error
+	// Map maps a request to its internal type.
+	Map(ctx context.Context, in *{{.InputName}}) ({{$in}}, error)
+	// Persist performs the rpc once every request has been received.
+	Persist(ctx context.Context, in []{{$in}}) ({{$out}}, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out {{$out}}) (*{{.ResponseName}}, error)
+}
+
+// {{.MethodName}} implements {{.MethodFullName}}.
+{{- template "methodDoc" .}}
+func (s *{{.StructName}}) {{.MethodName}}(svr {{.Ident}}.{{.ServiceName}}_{{.MethodName}}Server) error {
+	out, err := run{{.StructName}}{{.MethodName}}(svr.Context(), &{{.HookName}}{{.StructName}}Stages{s: s}, svr.Recv)
+	if err != nil {
+		return err
+	}
+	return svr.SendAndClose(out)
+}
+
+// run{{.StructName}}{{.MethodName}} runs the stages of {{.MethodFullName}} in order, receiving requests until the client closes the stream,
+// returning the error of the first stage to fail.
+func run{{.StructName}}{{.MethodName}}(ctx context.Context, stages {{.StructName}}{{.MethodName}}Stages, recv func() (*{{.InputName}}, error)) (*{{.ResponseName}}, error) {
+	var internal []{{$in}}
+	for {
+		in, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := stages.Validate(ctx, in); err != nil {
+			return nil, err
+		}
+
+		mapped, err := stages.Map(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		internal = append(internal, mapped)
+	}
+
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	return stages.Respond(ctx, result)
+}
+
+// {{.HookName}}{{.StructName}}Stages implements {{.StructName}}{{.MethodName}}Stages for the {{.StructName}}.
+type {{.HookName}}{{.StructName}}Stages struct {
+	s *{{.StructName}}
+}
+
+var _ {{.StructName}}{{.MethodName}}Stages = (*{{.HookName}}{{.StructName}}Stages)(nil)
+
+// Validate validates a request using the validation functions generated by the validation option.
+func (st *{{.HookName}}{{.StructName}}Stages) Validate(ctx context.Context, in *{{.InputName}}) error {
+{{- if .Validation}}
+	return validate{{.Method.Input.GoIdent.GoName}}(in)
+{{- else}}
+	return nil
+{{- end}}
+}
+
+// Map maps a request to its domain struct.
+func (st *{{.HookName}}{{.StructName}}Stages) Map(ctx context.Context, in *{{.InputName}}) ({{$in}}, error) {
+{{- if and .Domain (eq .Method.Input.GoIdent.GoImportPath .File.GoImportPath)}}
+	return {{.Method.Input.GoIdent.GoName}}FromProto(in), nil
+{{- else}}
+	return in, nil
+{{- end}}
+}
+
+// Persist performs the rpc.
+func (st *{{.HookName}}{{.StructName}}Stages) Persist(ctx context.Context, in []{{$in}}) ({{$out}}, error) {
+	return &{{slice $out 1}}{}, nil
+}
+
+// Respond maps the result to the response.
+func (st *{{.HookName}}{{.StructName}}Stages) Respond(ctx context.Context, out {{$out}}) (*{{.ResponseName}}, error) {
+{{- if and .Domain (eq .Method.Output.GoIdent.GoImportPath .File.GoImportPath)}}
+	return out.ToProto(), nil
+{{- else}}
+	return out, nil
+{{- end}}
+}
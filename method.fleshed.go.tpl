@@ -1,59 +1,84 @@
-// {{ .MethodName}} implements {{.MethodFullName}}.
-func (s *Service) {{ .MethodName}}(ctx context.Context, in *{{ .InputName}} ) (*{{ .ResponseName}} , error) {
-   	// validate request
-   	err := validate{{ .MethodName}}Input(ctx, in)
-   	if err != nil {
-   		return nil, err
-   	}
+import (
+	"context"
+)
+{{- /* messages of the proto go package are mapped to the domain structs generated by the domain option, when enabled. */}}
+{{- $in := printf "*%s" .InputName}}
+{{- if and .Domain (eq .Method.Input.GoIdent.GoImportPath .File.GoImportPath)}}{{$in = printf "*%s" .Method.Input.GoIdent.GoName}}{{end}}
+{{- $out := printf "*%s" .ResponseName}}
+{{- if and .Domain (eq .Method.Output.GoIdent.GoImportPath .File.GoImportPath)}}{{$out = printf "*%s" .Method.Output.GoIdent.GoName}}{{end}}
 
-   	// map to internal type
-   	internalType, err := map{{ .MethodName}}InputToInternal(ctx, in)
-   	if err != nil {
-   		return nil, err
-   	}
+// {{.StructName}}{{.MethodName}}Stages the stages of {{.MethodFullName}}, each stage is typed by the result of the previous stage.
+type {{.StructName}}{{.MethodName}}Stages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *{{.InputName}}) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *{{.InputName}}) ({{$in}}, error)
+	// Persist performs the rpc, including any downstream requests & database operations.
+	Persist(ctx context.Context, in {{$in}}) ({{$out}}, error)
+	// Respond maps the result of Persist to the response.
+	Respond(ctx context.Context, out {{$out}}) (*{{.ResponseName}}, error)
+}
 
-   	// perform any dowsntream requests prior to database interaction.
-   	downstreamResponse, err := s.preDatabaseDownstreams{{ .MethodName}}(ctx, internalType)
-   	if err != nil {
-   		return nil, err
-   	}
+// {{.MethodName}} implements {{.MethodFullName}}.
+{{- template "methodDoc" .}}
+func (s *{{.StructName}}) {{.MethodName}}(ctx context.Context, in *{{.InputName}}) (*{{.ResponseName}}, error) {
+	return run{{.StructName}}{{.MethodName}}(ctx, &{{.HookName}}{{.StructName}}Stages{s: s}, in)
+}
 
-   	// perform database operation
-   	databaseResponse, err := s.databaseOp{{ .MethodName}}(ctx, downstreamResponse, internalType)
-   	if err != nil {
-   		return nil, err
-   	}
+// run{{.StructName}}{{.MethodName}} runs the stages of {{.MethodFullName}} in order, returning the error of the first stage to fail.
+func run{{.StructName}}{{.MethodName}}(ctx context.Context, stages {{.StructName}}{{.MethodName}}Stages, in *{{.InputName}}) (*{{.ResponseName}}, error) {
+	if err := stages.Validate(ctx, in); err != nil {
+		return nil, err
+	}
 
-   	// perform any dowsntream requests post database interaction.
-   	postDbDownstreamResponse, err := s.postDatabaseDownstreams{{ .MethodName}}(ctx, databaseResponse)
-   	if err != nil {
-   		return nil, err
-   	}
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return nil, err
+	}
 
-   	// prepare response
-   	return prepare{{ .MethodName}}Response(ctx, internalType, downstreamResponse, databaseResponse, postDbDownstreamResponse)
-}
+	result, err := stages.Persist(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
 
-func (s *Service) preDatabaseDownstreams{{.MethodName}}(ctx context.Context, in any) (any, error) {
-	return nil, nil
+	return stages.Respond(ctx, result)
 }
 
-func (s *Service) databaseOp{{.MethodName}}(ctx context.Context, downstreamResponse any, internalType any) (any, error) {
-	return nil, nil
+// {{.HookName}}{{.StructName}}Stages implements {{.StructName}}{{.MethodName}}Stages for the {{.StructName}}.
+type {{.HookName}}{{.StructName}}Stages struct {
+	s *{{.StructName}}
 }
 
-func (s *Service) postDatabaseDownstreams{{.MethodName}}(ctx context.Context, in any) (any, error) {
-	return nil, nil
-}
+var _ {{.StructName}}{{.MethodName}}Stages = (*{{.HookName}}{{.StructName}}Stages)(nil)
 
-func validate{{.MethodName}}Input(ctx context.Context, in *temp.Example) error {
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *{{.HookName}}{{.StructName}}Stages) Validate(ctx context.Context, in *{{.InputName}}) error {
+{{- if .Validation}}
+	return validate{{.Method.Input.GoIdent.GoName}}(in)
+{{- else}}
 	return nil
+{{- end}}
+}
+
+// Map maps the request to its domain struct.
+func (st *{{.HookName}}{{.StructName}}Stages) Map(ctx context.Context, in *{{.InputName}}) ({{$in}}, error) {
+{{- if and .Domain (eq .Method.Input.GoIdent.GoImportPath .File.GoImportPath)}}
+	return {{.Method.Input.GoIdent.GoName}}FromProto(in), nil
+{{- else}}
+	return in, nil
+{{- end}}
 }
 
-func map{{.MethodName}}InputToInternal(ctx context.Context, in *temp.Example) (any, error) {
-	return nil, nil
+// Persist performs the rpc.
+func (st *{{.HookName}}{{.StructName}}Stages) Persist(ctx context.Context, in {{$in}}) ({{$out}}, error) {
+	return &{{slice $out 1}}{}, nil
 }
 
-func prepare{{.MethodName}}Response(ctx context.Context, downstreamResponse any, internalType any, databaseType any, postDbDownstreamResponse any) (*{{ .ResponseName}}, error) {
-	return nil, nil
+// Respond maps the result to the response.
+func (st *{{.HookName}}{{.StructName}}Stages) Respond(ctx context.Context, out {{$out}}) (*{{.ResponseName}}, error) {
+{{- if and .Domain (eq .Method.Output.GoIdent.GoImportPath .File.GoImportPath)}}
+	return out.ToProto(), nil
+{{- else}}
+	return out, nil
+{{- end}}
 }
@@ -0,0 +1,85 @@
+import (
+	"context"
+)
+{{- /* messages of the proto go package are mapped to the domain structs generated by the domain option, when enabled. */}}
+{{- $in := printf "*%s" .InputName}}
+{{- if and .Domain (eq .Method.Input.GoIdent.GoImportPath .File.GoImportPath)}}{{$in = printf "*%s" .Method.Input.GoIdent.GoName}}{{end}}
+{{- $out := printf "*%s" .ResponseName}}
+{{- if and .Domain (eq .Method.Output.GoIdent.GoImportPath .File.GoImportPath)}}{{$out = printf "*%s" .Method.Output.GoIdent.GoName}}{{end}}
+
+// {{.StructName}}{{.MethodName}}Stages the stages of {{.MethodFullName}}, each stage is typed by the result of the previous stage.
+type {{.StructName}}{{.MethodName}}Stages interface {
+	// Validate rejects invalid requests.
+	Validate(ctx context.Context, in *{{.InputName}}) error
+	// Map maps the request to its internal type.
+	Map(ctx context.Context, in *{{.InputName}}) ({{$in}}, error)
+	// Persist performs the rpc, calling send for every result to be streamed.
+	Persist(ctx context.Context, in {{$in}}, send func({{$out}}) error) error
+	// Respond maps a result of Persist to a response.
+	Respond(ctx context.Context, out {{$out}}) (*{{.ResponseName}}, error)
+}
+
+// {{.MethodName}} implements {{.MethodFullName}}.
+{{- template "methodDoc" .}}
+func (s *{{.StructName}}) {{.MethodName}}(in *{{.InputName}}, svr {{.Ident}}.{{.ServiceName}}_{{.MethodName}}Server) error {
+	return run{{.StructName}}{{.MethodName}}(svr.Context(), &{{.HookName}}{{.StructName}}Stages{s: s}, in, svr.Send)
+}
+
+// run{{.StructName}}{{.MethodName}} runs the stages of {{.MethodFullName}} in order, sending every response, returning the error of the first stage to fail.
+func run{{.StructName}}{{.MethodName}}(ctx context.Context, stages {{.StructName}}{{.MethodName}}Stages, in *{{.InputName}}, send func(*{{.ResponseName}}) error) error {
+	if err := stages.Validate(ctx, in); err != nil {
+		return err
+	}
+
+	internal, err := stages.Map(ctx, in)
+	if err != nil {
+		return err
+	}
+
+	return stages.Persist(ctx, internal, func(result {{$out}}) error {
+		out, err := stages.Respond(ctx, result)
+		if err != nil {
+			return err
+		}
+		return send(out)
+	})
+}
+
+// {{.HookName}}{{.StructName}}Stages implements {{.StructName}}{{.MethodName}}Stages for the {{.StructName}}.
+type {{.HookName}}{{.StructName}}Stages struct {
+	s *{{.StructName}}
+}
+
+var _ {{.StructName}}{{.MethodName}}Stages = (*{{.HookName}}{{.StructName}}Stages)(nil)
+
+// Validate validates the request using the validation functions generated by the validation option.
+func (st *{{.HookName}}{{.StructName}}Stages) Validate(ctx context.Context, in *{{.InputName}}) error {
+{{- if .Validation}}
+	return validate{{.Method.Input.GoIdent.GoName}}(in)
+{{- else}}
+	return nil
+{{- end}}
+}
+
+// Map maps the request to its domain struct.
+func (st *{{.HookName}}{{.StructName}}Stages) Map(ctx context.Context, in *{{.InputName}}) ({{$in}}, error) {
+{{- if and .Domain (eq .Method.Input.GoIdent.GoImportPath .File.GoImportPath)}}
+	return {{.Method.Input.GoIdent.GoName}}FromProto(in), nil
+{{- else}}
+	return in, nil
+{{- end}}
+}
+
+// Persist performs the rpc.
+func (st *{{.HookName}}{{.StructName}}Stages) Persist(ctx context.Context, in {{$in}}, send func({{$out}}) error) error {
+	return nil
+}
+
+// Respond maps a result to a response.
+func (st *{{.HookName}}{{.StructName}}Stages) Respond(ctx context.Context, out {{$out}}) (*{{.ResponseName}}, error) {
+{{- if and .Domain (eq .Method.Output.GoIdent.GoImportPath .File.GoImportPath)}}
+	return out.ToProto(), nil
+{{- else}}
+	return out, nil
+{{- end}}
+}
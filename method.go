@@ -1,7 +1,11 @@
 package main
 
 import (
+	"go/token"
+	"strings"
+
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // Method contains all info for method generation.
@@ -16,12 +20,70 @@ type Method struct {
 	FileGoPkgName string
 	// ServiceName is the name of the service to which the method belongs.
 	ServiceName string
+	// StructName is the name of the struct implementing the service.
+	StructName string
 	// Ident the file pkg name.
 	Ident string
+	// ConnectGoImportPath generated connect import path.
+	ConnectGoImportPath string
 	// InputName import path and type name e.g foo.Bar.
 	InputName string
 	// ResponseName import path and type name for the rpc response e.g foo.Bar.
 	ResponseName string
+	// LeadingComments proto comments preceding the rpc formatted as go comments.
+	LeadingComments string
+	// TrailingComments proto comments following the rpc formatted as go comments.
+	TrailingComments string
+	// Deprecated whether the rpc has been marked as deprecated.
+	Deprecated bool
+	// Values key/values declared via the (boilerplate.method) proto option.
+	Values map[string]string
+	// HookName unexported name of the method implemented by user code when methods are split.
+	HookName string
+	// Validation whether validation functions are generated for the requests of the package e.g validateGetBookRequest.
+	Validation bool
+	// Domain whether domain structs are generated for the messages of the package e.g GetBookRequestFromProto.
+	Domain bool
 	// Method *protogen.Method.
 	Method *protogen.Method
+	// File the proto file declaring the rpc.
+	File *protogen.File
+}
+
+// newMethod creates the Method data for a rpc, message types are qualified relative to the generated file.
+func newMethod(file *protogen.File, method *protogen.Method, ident string, structName string, f *protogen.GeneratedFile) Method {
+	return Method{
+		MethodName:          method.GoName,
+		MethodFullName:      string(method.Desc.FullName()),
+		ServiceName:         method.Parent.GoName,
+		StructName:          structName,
+		InputName:           messageImportPath(method.Input, f),
+		ResponseName:        messageImportPath(method.Output, f),
+		Ident:               ident,
+		ConnectGoImportPath: connectPath(file).String(),
+		LeadingComments:     formatComments(method.Comments.Leading),
+		TrailingComments:    formatComments(method.Comments.Trailing),
+		Deprecated:          method.Desc.Options().(*descriptorpb.MethodOptions).GetDeprecated(),
+		Values:              methodOptions(method).GetValues(),
+		HookName:            hookName(method.GoName),
+		Method:              method,
+		File:                file,
+		FileGoPkgName:       string(file.GoPackageName),
+	}
+}
+
+// hookName returns the unexported name of the method implemented by user code when methods are split e.g getBook.
+//
+// names which are go keywords e.g type are suffixed by an underscore, as protoc-gen-go does.
+func hookName(goName string) string {
+	name := strings.ToLower(goName[:1]) + goName[1:]
+	if token.IsKeyword(name) {
+		name += "_"
+	}
+	return name
+}
+
+// formatComments formats proto comments as go comments without a trailing new line.
+func formatComments(comments protogen.Comments) string {
+	return strings.TrimSuffix(comments.String(), "\n")
 }
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHookName(t *testing.T) {
+	tests := map[string]string{
+		"GetBook": "getBook",
+		"Type":    "type_",
+		"Go":      "go_",
+		"Range":   "range_",
+		"Types":   "types",
+	}
+	for goName, want := range tests {
+		if got := hookName(goName); got != want {
+			t.Errorf("hookName(%s) = %s, want %s", goName, got, want)
+		}
+	}
+}
+
+func TestSplitKeywordHooks(t *testing.T) {
+	// rpcs named after go keywords once unexported are generated with escaped hooks.
+	for _, templateDirectory := range []string{"templates", "templates/connect"} {
+		dir := tempDir(t)
+		generated := generateFiles(t, compileRequest(t, "testdata/split", "split=true,outputRoot="+dir+",templateDirectory="+templateDirectory))
+		base := generated["namesapi/zz_generated_type.go"]
+		if !strings.Contains(base, "s.type_(") {
+			t.Errorf("%s: namesapi/zz_generated_type.go does not call the escaped hook\n%s", templateDirectory, base)
+		}
+	}
+}
+
+func TestSplitPackageFileClash(t *testing.T) {
+	dir := tempDir(t)
+	opts, f := plugin()
+	resp, err := generate(opts, f, compileRequest(t, "testdata/clash", "split=true,outputRoot="+dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "clash.ClashAPI.Builders: split base file clashapi/zz_generated_builders.go clashes with a file generated for the package"
+	if !strings.Contains(resp.GetError(), want) {
+		t.Fatalf("got error %q, want %q", resp.GetError(), want)
+	}
+}
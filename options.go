@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/lcmaguire/protoc-gen-go-boilerplate/gen/boilerplate"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// serviceOptions returns the boilerplate options declared on the service.
+func serviceOptions(service *protogen.Service) *boilerplate.ServiceOptions {
+	options, _ := proto.GetExtension(service.Desc.Options(), boilerplate.E_Service).(*boilerplate.ServiceOptions)
+	return options
+}
+
+// methodOptions returns the boilerplate options declared on the method.
+func methodOptions(method *protogen.Method) *boilerplate.MethodOptions {
+	options, _ := proto.GetExtension(method.Desc.Options(), boilerplate.E_Method).(*boilerplate.MethodOptions)
+	return options
+}
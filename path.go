@@ -0,0 +1,11 @@
+package main
+
+// Path data used to execute the pathPattern option.
+type Path struct {
+	// ServiceName the name of the service the file is generated for.
+	ServiceName string
+	// FileGoPkgName go package for the proto file.
+	FileGoPkgName string
+	// Name the name of the file without its extension, the rpc name for methods otherwise the kind of file e.g service.
+	Name string
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	pathsImport         = "import"
+	pathsSourceRelative = "source_relative"
+)
+
+// pluginParams parameters consumed by protogen which also affect the output of this plugin.
+type pluginParams struct {
+	// paths the value of the paths parameter, empty when not provided.
+	paths string
+	// module the value of the module parameter, stripped from the names of generated files.
+	module string
+}
+
+// parsePluginParams parses the parameters consumed by protogen from the request parameter.
+func parsePluginParams(parameter string) pluginParams {
+	var params pluginParams
+	for _, param := range strings.Split(parameter, ",") {
+		key, value, _ := strings.Cut(param, "=")
+		switch key {
+		case "paths":
+			params.paths = value
+		case "module":
+			params.module = value
+		}
+	}
+	return params
+}
+
+// trimModule strips the module prefix from a generated file name, as protogen does when writing the response.
+func (p pluginParams) trimModule(fileName string) string {
+	if p.module == "" {
+		return fileName
+	}
+	return strings.TrimPrefix(fileName, p.module+"/")
+}
+
+// output files emitted as is, bypassing the formatting protogen applies to its generated files.
+type output struct {
+	files []*pluginpb.CodeGeneratorResponse_File
+}
+
+// emit adds the file to the response.
+func (o *output) emit(fileName string, content []byte) {
+	o.files = append(o.files, &pluginpb.CodeGeneratorResponse_File{
+		Name:    proto.String(fileName),
+		Content: proto.String(string(content)),
+	})
+}
+
+// response returns the response of protogen along with the emitted files, stripping the module prefix from their names.
+func (o *output) response(gen *protogen.Plugin, params pluginParams) *pluginpb.CodeGeneratorResponse {
+	resp := gen.Response()
+	if resp.Error != nil {
+		return resp
+	}
+
+	for _, f := range o.files {
+		if params.module != "" && !strings.HasPrefix(f.GetName(), params.module+"/") {
+			resp.Error = proto.String(fmt.Sprintf("%v: generated file does not match prefix %q", f.GetName(), params.module))
+			resp.File = nil
+			return resp
+		}
+		f.Name = proto.String(params.trimModule(f.GetName()))
+		resp.File = append(resp.File, f)
+	}
+	return resp
+}
+
+// run is equivalent to protogen.Options.Run, additionally providing the parameters consumed by protogen to f
+// & allowing f to emit files as is.
+func run(opts protogen.Options, f func(gen *protogen.Plugin, params pluginParams, out *output) error) {
+	if len(os.Args) > 1 {
+		fmt.Fprintf(os.Stderr, "unknown argument %q (this program should be run by protoc, not directly)\n", os.Args[1])
+		os.Exit(1)
+	}
+
+	if err := runPlugin(opts, f); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", filepath.Base(os.Args[0]), err)
+		os.Exit(1)
+	}
+}
+
+func runPlugin(opts protogen.Options, f func(gen *protogen.Plugin, params pluginParams, out *output) error) error {
+	in, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(in, req); err != nil {
+		return err
+	}
+
+	resp, err := generate(opts, f, req)
+	if err != nil {
+		return err
+	}
+
+	out, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// generate runs f against the request, returning the response which would be written to stdout.
+func generate(opts protogen.Options, f func(gen *protogen.Plugin, params pluginParams, out *output) error, req *pluginpb.CodeGeneratorRequest) (*pluginpb.CodeGeneratorResponse, error) {
+	gen, err := opts.New(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := parsePluginParams(req.GetParameter())
+	emitted := &output{}
+	if err := f(gen, params, emitted); err != nil {
+		// errors from the plugin function are reported by setting the error field in the response.
+		gen.Error(err)
+	}
+	return emitted.response(gen, params), nil
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	// modulePath the path of the go module.
+	modulePath = "github.com/lcmaguire/protoc-gen-go-boilerplate"
+	// goPackagePrefix the go_package_prefix of the managed mode of the buf.gen yaml files.
+	goPackagePrefix = modulePath + "/gen"
+)
+
+// compileRequest compiles the files within dir into a request, every file when none are provided, as buf generate does
+// with the managed mode of the buf.gen yaml files. dependencies e.g buf/validate/validate.proto are resolved from their go bindings.
+func compileRequest(t testing.TB, dir string, parameter string, files ...string) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+
+	if len(files) == 0 {
+		err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(p) != ".proto" {
+				return err
+			}
+			rel, err := filepath.Rel(dir, p)
+			files = append(files, filepath.ToSlash(rel))
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(protocompile.CompositeResolver{
+			&protocompile.SourceResolver{ImportPaths: []string{dir}},
+			protocompile.ResolverFunc(func(p string) (protocompile.SearchResult, error) {
+				fd, err := protoregistry.GlobalFiles.FindFileByPath(p)
+				return protocompile.SearchResult{Desc: fd}, err
+			}),
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	compiled, err := compiler.Compile(context.Background(), files...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &pluginpb.CodeGeneratorRequest{FileToGenerate: files, Parameter: proto.String(parameter)}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		// files are added after their imports, as protoc does.
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+
+		fdp := protodesc.ToFileDescriptorProto(fd)
+		if fdp.GetOptions().GetGoPackage() == "" {
+			if fdp.Options == nil {
+				fdp.Options = &descriptorpb.FileOptions{}
+			}
+			fdp.Options.GoPackage = proto.String(path.Join(goPackagePrefix, path.Dir(fd.Path())))
+		}
+		req.ProtoFile = append(req.ProtoFile, fdp)
+	}
+	for _, fd := range compiled {
+		add(fd)
+	}
+
+	// the request is read from its wire format as the plugin would, resolving the options of the compiled files.
+	raw, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(raw, req); err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+// generateFiles runs the plugin against the request, returning the content of the generated files by their name.
+func generateFiles(t testing.TB, req *pluginpb.CodeGeneratorRequest) map[string]string {
+	t.Helper()
+
+	opts, f := plugin()
+	resp, err := generate(opts, f, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+
+	generated := map[string]string{}
+	for _, file := range resp.File {
+		generated[file.GetName()] = file.GetContent()
+	}
+	return generated
+}
+
+// tempDir returns a new directory within testdata, removed once the test completes.
+//
+// the directory is within the module so generated code written to it may import the packages of the gen directory.
+func tempDir(t testing.TB) string {
+	t.Helper()
+
+	if err := os.MkdirAll("testdata", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := os.MkdirTemp("testdata", strings.ReplaceAll(t.Name(), "/", "_"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.ToSlash(dir)
+}
+
+// writeFiles writes the generated files to the directory.
+func writeFiles(t testing.TB, dir string, generated map[string]string) {
+	t.Helper()
+
+	for name, content := range generated {
+		fileName := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fileName), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fileName, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// goBindings moves the files to generate into go packages within dir e.g <dir>/rangespb, returning their go bindings
+// as protoc-gen-go would generate them, keyed by their path relative to dir.
+//
+// the bindings allow code generated from protos which are not declared within the proto directory to be compiled.
+func goBindings(t testing.TB, dir string, req *pluginpb.CodeGeneratorRequest) map[string]string {
+	t.Helper()
+
+	for _, fdp := range req.ProtoFile {
+		if slices.Contains(req.FileToGenerate, fdp.GetName()) {
+			fdp.Options.GoPackage = proto.String(path.Join(modulePath, dir, path.Dir(fdp.GetName())+"pb"))
+		}
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range gen.Files {
+		if file.Generate {
+			gengo.GenerateFile(gen, file)
+		}
+	}
+	resp := gen.Response()
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+
+	bindings := map[string]string{}
+	for _, file := range resp.File {
+		bindings[strings.TrimPrefix(file.GetName(), path.Join(modulePath, dir)+"/")] = file.GetContent()
+	}
+	return bindings
+}
+
+func TestParsePluginParams(t *testing.T) {
+	tests := map[string]pluginParams{
+		"":                                  {},
+		"paths=import":                      {paths: pathsImport},
+		"paths=source_relative,server=true": {paths: pathsSourceRelative},
+		"module=example.com/m,paths=import": {paths: pathsImport, module: "example.com/m"},
+		"templateDirectory=templates,module=a/b/c": {module: "a/b/c"},
+	}
+	for parameter, want := range tests {
+		if got := parsePluginParams(parameter); got != want {
+			t.Errorf("parsePluginParams(%q) = %+v, want %+v", parameter, got, want)
+		}
+	}
+}
+
+func TestTrimModule(t *testing.T) {
+	tests := []struct {
+		module   string
+		fileName string
+		want     string
+	}{
+		{fileName: "example.com/m/api/service.go", want: "example.com/m/api/service.go"},
+		{module: "example.com/m", fileName: "example.com/m/api/service.go", want: "api/service.go"},
+		{module: "example.com/m", fileName: "example.com/mod/api/service.go", want: "example.com/mod/api/service.go"},
+		{module: "example.com/m", fileName: "example.com/m", want: "example.com/m"},
+	}
+	for _, tt := range tests {
+		if got := (pluginParams{module: tt.module}).trimModule(tt.fileName); got != tt.want {
+			t.Errorf("trimModule(%q) with module %q = %q, want %q", tt.fileName, tt.module, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/imports"
+	gofumpt "mvdan.cc/gofumpt/format"
+)
+
+// postProcessor transforms the content of a generated go file once rendered.
+type postProcessor func(fileName string, content []byte) ([]byte, error)
+
+// postProcessors returns the post processors which can be selected via the postProcess option.
+func postProcessors(goimports postProcessor) map[string]postProcessor {
+	return map[string]postProcessor{
+		// gofmt formats the file.
+		"gofmt": gofmt,
+		// goimports formats imports into one import group and removes any unused imports.
+		"goimports": goimports,
+		// gofumpt applies the stricter formatting rules of gofumpt.
+		"gofumpt": stricterFormat,
+	}
+}
+
+// defaultPostProcessors the post processors applied when the postProcess option is not provided.
+var defaultPostProcessors = []string{"gofmt", "goimports"}
+
+// noPostProcessors disables post processing, emitting files as formatted by protogen.
+const noPostProcessors = "none"
+
+// processorNames names of the post processors provided via the repeatable postProcess option.
+type processorNames []string
+
+// String implements flag.Value.
+func (n *processorNames) String() string {
+	if n == nil {
+		return ""
+	}
+	return strings.Join(*n, ",")
+}
+
+// Set implements flag.Value.
+func (n *processorNames) Set(value string) error {
+	*n = append(*n, value)
+	return nil
+}
+
+// newPostProcessChain returns a post processor applying the named post processors in order.
+//
+// goimports is used to tidy imports e.g tidyImports or hermeticImports.
+func newPostProcessChain(names []string, goimports postProcessor) (postProcessor, error) {
+	if len(names) == 0 {
+		names = defaultPostProcessors
+	}
+
+	processors := postProcessors(goimports)
+
+	var chain []postProcessor
+	for _, name := range names {
+		if name == noPostProcessors {
+			continue
+		}
+
+		processor, ok := processors[name]
+		if !ok {
+			available := make([]string, 0, len(processors))
+			for name := range processors {
+				available = append(available, name)
+			}
+			sort.Strings(available)
+			return nil, fmt.Errorf("unknown post processor %q, must be one of %s or %s", name, strings.Join(available, ", "), noPostProcessors)
+		}
+		chain = append(chain, processor)
+	}
+
+	return func(fileName string, content []byte) ([]byte, error) {
+		var err error
+		for _, processor := range chain {
+			content, err = processor(fileName, content)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return content, nil
+	}, nil
+}
+
+// failedPostProcessor returns a post processor failing with err, reporting the error along with the file being processed.
+func failedPostProcessor(err error) postProcessor {
+	return func(string, []byte) ([]byte, error) {
+		return nil, err
+	}
+}
+
+// gofmt formats the file.
+func gofmt(_ string, content []byte) ([]byte, error) {
+	return format.Source(content)
+}
+
+// tidyImports will format imports into one import group and will remove any unused imports.
+func tidyImports(fileName string, content []byte) ([]byte, error) {
+	content, err := dedupeImports(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return imports.Process(fileName, content, nil) // opt nil will result in default behaviour.
+}
+
+// stricterFormat formats the file with gofumpt.
+func stricterFormat(_ string, content []byte) ([]byte, error) {
+	return gofumpt.Source(content, gofumpt.Options{})
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPostProcessChain(t *testing.T) {
+	const unformatted = "package foo\nfunc F()  {\n}\n"
+	const formatted = "package foo\n\nfunc F() {\n}\n"
+
+	tests := map[string]struct {
+		names []string
+		// calls the content goimports is called with, in order.
+		calls []string
+		want  string
+	}{
+		"default": {
+			calls: []string{formatted},
+			want:  formatted + "\n// goimports\n",
+		},
+		"in order": {
+			names: []string{"goimports", "gofmt"},
+			calls: []string{unformatted},
+			want:  formatted + "\n// goimports\n",
+		},
+		"repeated": {
+			names: []string{"goimports", "gofmt", "goimports"},
+			calls: []string{unformatted, formatted + "\n// goimports\n"},
+			want:  formatted + "\n// goimports\n\n// goimports\n",
+		},
+		"gofumpt": {
+			names: []string{"gofumpt"},
+			want:  formatted,
+		},
+		"none": {
+			names: []string{"none"},
+			want:  unformatted,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			goimports := func(_ string, content []byte) ([]byte, error) {
+				calls = append(calls, string(content))
+				return append(content, "\n// goimports\n"...), nil
+			}
+
+			process, err := newPostProcessChain(tt.names, goimports)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := process("foo.go", []byte(unformatted))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+			if strings.Join(calls, "|") != strings.Join(tt.calls, "|") {
+				t.Errorf("goimports got called with %q, want %q", calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestPostProcessChainErrors(t *testing.T) {
+	failing := errors.New("failing")
+
+	t.Run("unknown post processor", func(t *testing.T) {
+		_, err := newPostProcessChain([]string{"gofmt", "prettier"}, tidyImports)
+		want := `unknown post processor "prettier", must be one of gofmt, gofumpt, goimports or none`
+		if err == nil || err.Error() != want {
+			t.Fatalf("got error %v, want %q", err, want)
+		}
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		called := false
+		goimports := func(_ string, content []byte) ([]byte, error) {
+			called = true
+			return content, nil
+		}
+
+		process, err := newPostProcessChain([]string{"gofmt", "goimports"}, goimports)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := process("foo.go", []byte("package foo\nfunc {")); err == nil {
+			t.Fatal("got no error formatting invalid go")
+		}
+		if called {
+			t.Error("goimports was called after gofmt failed")
+		}
+	})
+
+	t.Run("post processor error", func(t *testing.T) {
+		goimports := func(string, []byte) ([]byte, error) {
+			return nil, failing
+		}
+
+		process, err := newPostProcessChain(nil, goimports)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := process("foo.go", []byte("package foo\n")); !errors.Is(err, failing) {
+			t.Fatalf("got error %v, want %v", err, failing)
+		}
+	})
+}
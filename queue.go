@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"text/template"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// renderJob a template to be rendered to a generated file.
+type renderJob struct {
+	file     *protogen.GeneratedFile
+	fileName string
+	template *template.Template
+	data     any
+	// process post processes the content of the file once rendered e.g tidying its imports.
+	process postProcessor
+
+	content []byte
+	err     error
+}
+
+// renderQueue renders templates concurrently, emitting the results in the order they were queued
+// so the generated response is deterministic.
+type renderQueue struct {
+	jobs []*renderJob
+}
+
+// add queues the template to be rendered to the file, the file must not be written to once queued.
+func (q *renderQueue) add(f *protogen.GeneratedFile, fileName string, t *template.Template, data any, process postProcessor) {
+	q.jobs = append(q.jobs, &renderJob{file: f, fileName: fileName, template: t, data: data, process: process})
+}
+
+// run renders & post processes every queued job, emitting the processed content of each file once.
+//
+// every file is only written to by the goroutine rendering it, files are emitted in the order jobs were queued.
+func (q *renderQueue) run(out *output) error {
+	var wg sync.WaitGroup
+	limit := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for _, job := range q.jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			job.content, job.err = job.render()
+		}()
+	}
+	wg.Wait()
+
+	for _, job := range q.jobs {
+		if job.err != nil {
+			return fmt.Errorf("%s: %w", job.fileName, job.err)
+		}
+
+		// the file has only been used to render the template & is emitted with its processed content instead.
+		job.file.Skip()
+		out.emit(job.fileName, job.content)
+	}
+
+	q.jobs = nil
+	return nil
+}
+
+// render renders the template to the file & post processes its content, which protogen has formatted & added imports to.
+func (j *renderJob) render() ([]byte, error) {
+	if err := render(j.template, j.file, j.data); err != nil {
+		return nil, err
+	}
+
+	content, err := j.file.Content()
+	if err != nil {
+		return nil, err
+	}
+	return j.process(j.fileName, content)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"text/template"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestRenderQueue(t *testing.T) {
+	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := template.Must(template.New("method").Parse("\nfunc F{{.}}() {}\n"))
+	suffix := func(_ string, content []byte) ([]byte, error) {
+		return append(content, "// processed\n"...), nil
+	}
+
+	// many more jobs than goroutines are queued, so jobs complete out of order.
+	queue := &renderQueue{}
+	var want []string
+	for i := range 100 {
+		fileName := fmt.Sprintf("foo/f%d.go", i)
+		f := gen.NewGeneratedFile(fileName, ".")
+		f.P("package foo")
+		queue.add(f, fileName, tmpl, i, suffix)
+		want = append(want, fileName)
+	}
+
+	out := &output{}
+	if err := queue.run(out); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out.files) != len(want) {
+		t.Fatalf("got %d files, want %d", len(out.files), len(want))
+	}
+	for i, file := range out.files {
+		if file.GetName() != want[i] {
+			t.Fatalf("file %d: got %s, want files emitted in the order they were queued", i, file.GetName())
+		}
+		if !strings.Contains(file.GetContent(), fmt.Sprintf("func F%d() {}", i)) {
+			t.Errorf("%s: template was not rendered\n%s", file.GetName(), file.GetContent())
+		}
+		if !strings.HasSuffix(file.GetContent(), "// processed\n") {
+			t.Errorf("%s: content was not post processed\n%s", file.GetName(), file.GetContent())
+		}
+	}
+
+	if len(queue.jobs) != 0 {
+		t.Errorf("got %d jobs, want the queue to be emptied once run", len(queue.jobs))
+	}
+
+	// files are emitted as is rather than via the response of protogen.
+	if resp := gen.Response(); len(resp.File) != 0 {
+		t.Errorf("got %d files within the response of protogen, want every file to be skipped", len(resp.File))
+	}
+}
+
+func TestRenderQueueErrors(t *testing.T) {
+	failing := errors.New("failing")
+
+	tests := map[string]struct {
+		template string
+		process  postProcessor
+		err      string
+	}{
+		"template": {
+			template: "{{.Missing}}",
+			process:  gofmt,
+			err:      "bar/b.go: template: method:1:2: executing",
+		},
+		"post processor": {
+			template: "\nfunc F() {}\n",
+			process: func(string, []byte) ([]byte, error) {
+				return nil, failing
+			},
+			err: "bar/b.go: failing",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			queue := &renderQueue{}
+			valid := gen.NewGeneratedFile("bar/a.go", ".")
+			valid.P("package bar")
+			queue.add(valid, "bar/a.go", template.Must(template.New("method").Parse("")), nil, gofmt)
+
+			invalid := gen.NewGeneratedFile("bar/b.go", ".")
+			invalid.P("package bar")
+			queue.add(invalid, "bar/b.go", template.Must(template.New("method").Parse(tt.template)), struct{}{}, tt.process)
+
+			// the error is reported with the name of the file which failed.
+			err = queue.run(&output{})
+			if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Fatalf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// templateRule selects the template used for methods whose name matches a pattern.
+type templateRule struct {
+	// pattern the glob the method name or full name is matched against e.g List*.
+	pattern string
+	// expr the regular expression the method full name is matched against, set when the pattern is wrapped in slashes.
+	expr *regexp.Regexp
+	// template the template file used for matching methods.
+	template string
+}
+
+// templateRules rules provided via the repeatable methodTemplate option, the first matching rule applies.
+type templateRules []templateRule
+
+// String implements flag.Value.
+func (r *templateRules) String() string {
+	if r == nil {
+		return ""
+	}
+
+	rules := make([]string, 0, len(*r))
+	for _, rule := range *r {
+		rules = append(rules, rule.pattern+":"+rule.template)
+	}
+	return strings.Join(rules, ",")
+}
+
+// Set implements flag.Value, parsing a rule of the form pattern:template e.g List*:templates/list.tmpl.
+func (r *templateRules) Set(value string) error {
+	i := strings.LastIndex(value, ":")
+	if i <= 0 || i == len(value)-1 {
+		return fmt.Errorf("method template rule %q must be of the form pattern:template", value)
+	}
+
+	rule := templateRule{pattern: value[:i], template: value[i+1:]}
+	if len(rule.pattern) > 1 && strings.HasPrefix(rule.pattern, "/") && strings.HasSuffix(rule.pattern, "/") {
+		expr, err := regexp.Compile(rule.pattern[1 : len(rule.pattern)-1])
+		if err != nil {
+			return fmt.Errorf("method template rule %q: %w", value, err)
+		}
+		rule.expr = expr
+	} else if _, err := path.Match(rule.pattern, ""); err != nil {
+		return fmt.Errorf("method template rule %q: %w", value, err)
+	}
+
+	*r = append(*r, rule)
+	return nil
+}
+
+// match returns the template of the first rule matching the method, empty if no rule matches.
+//
+// globs match either the go name e.g ListBooks or the full name e.g library.LibraryService.ListBooks
+// & regular expressions match the full name.
+func (r templateRules) match(method *protogen.Method) string {
+	fullName := string(method.Desc.FullName())
+	for _, rule := range r {
+		if rule.expr != nil {
+			if rule.expr.MatchString(fullName) {
+				return rule.template
+			}
+			continue
+		}
+
+		if ok, _ := path.Match(rule.pattern, method.GoName); ok {
+			return rule.template
+		}
+		if ok, _ := path.Match(rule.pattern, fullName); ok {
+			return rule.template
+		}
+	}
+	return ""
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestTemplateRulesSet(t *testing.T) {
+	tests := map[string]struct {
+		pattern  string
+		template string
+		regexp   bool
+		err      string
+	}{
+		"List*:list.tmpl":                    {pattern: "List*", template: "list.tmpl"},
+		"library.*.Get*:get.tmpl":            {pattern: "library.*.Get*", template: "get.tmpl"},
+		"/^library\\..*/:library.tmpl":       {pattern: "/^library\\..*/", template: "library.tmpl", regexp: true},
+		"/^(?:library|shelf)\\./:named.tmpl": {pattern: "/^(?:library|shelf)\\./", template: "named.tmpl", regexp: true},
+		"a:b:c.tmpl":                         {pattern: "a:b", template: "c.tmpl"},
+		"List*":                              {err: "must be of the form pattern:template"},
+		":list.tmpl":                         {err: "must be of the form pattern:template"},
+		"List*:":                             {err: "must be of the form pattern:template"},
+		"[List:list.tmpl":                    {err: "syntax error in pattern"},
+		"/(List/:list.tmpl":                  {err: "missing closing )"},
+	}
+
+	for value, tt := range tests {
+		var rules templateRules
+		err := rules.Set(value)
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Set(%q): got error %v, want %s", value, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Set(%q): %v", value, err)
+			continue
+		}
+
+		rule := rules[0]
+		if rule.pattern != tt.pattern || rule.template != tt.template || (rule.expr != nil) != tt.regexp {
+			t.Errorf("Set(%q) = {pattern: %q, template: %q, regexp: %t}, want {pattern: %q, template: %q, regexp: %t}",
+				value, rule.pattern, rule.template, rule.expr != nil, tt.pattern, tt.template, tt.regexp)
+		}
+	}
+}
+
+func TestTemplateRulesMatch(t *testing.T) {
+	// the methods of names.proto are names.NamesAPI.Type & names.NamesAPI.Go.
+	gen, err := protogen.Options{}.New(compileRequest(t, "testdata/split", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	methods := map[string]*protogen.Method{}
+	for _, file := range gen.Files {
+		for _, service := range file.Services {
+			for _, method := range service.Methods {
+				methods[method.GoName] = method
+			}
+		}
+	}
+
+	tests := map[string]struct {
+		rules []string
+		// want the template matched by each method.
+		want map[string]string
+	}{
+		"go name glob": {
+			rules: []string{"T*:type.tmpl"},
+			want:  map[string]string{"Type": "type.tmpl", "Go": ""},
+		},
+		"full name glob": {
+			rules: []string{"names.NamesAPI.G?:go.tmpl"},
+			want:  map[string]string{"Type": "", "Go": "go.tmpl"},
+		},
+		"full name glob spanning the service": {
+			rules: []string{"names.*:names.tmpl"},
+			want:  map[string]string{"Type": "names.tmpl", "Go": "names.tmpl"},
+		},
+		"glob matching neither name": {
+			rules: []string{"library.*:library.tmpl", "Get*:get.tmpl"},
+			want:  map[string]string{"Type": "", "Go": ""},
+		},
+		"regexp full name": {
+			rules: []string{"/^names\\.NamesAPI\\./:names.tmpl"},
+			want:  map[string]string{"Type": "names.tmpl", "Go": "names.tmpl"},
+		},
+		"regexp not matching go name": {
+			rules: []string{"/^Type$/:type.tmpl"},
+			want:  map[string]string{"Type": "", "Go": ""},
+		},
+		"first match wins": {
+			rules: []string{"Go:first.tmpl", "/Go$/:second.tmpl", "*:rest.tmpl"},
+			want:  map[string]string{"Type": "rest.tmpl", "Go": "first.tmpl"},
+		},
+		"pattern containing a colon": {
+			rules: []string{"/^names\\.(?:NamesAPI)\\.Type$/:type.tmpl"},
+			want:  map[string]string{"Type": "type.tmpl", "Go": ""},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rules templateRules
+			for _, rule := range tt.rules {
+				if err := rules.Set(rule); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for goName, want := range tt.want {
+				if got := rules.match(methods[goName]); got != want {
+					t.Errorf("%s matched %q, want %q", goName, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	protoPackage      = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	anypbPackage      = protogen.GoImportPath("google.golang.org/protobuf/types/known/anypb")
+	wrapperspbPackage = protogen.GoImportPath("google.golang.org/protobuf/types/known/wrapperspb")
+
+	anyFullName = protoreflect.FullName("google.protobuf.Any")
+)
+
+// sampleMessage renders a go composite literal of the message with a sample value for every field.
+//
+// for oneofs only the first field is populated, recursive messages are left empty once already visited.
+func sampleMessage(f *protogen.GeneratedFile, message *protogen.Message, visited map[protoreflect.FullName]bool) string {
+	if message.Desc.FullName() == anyFullName {
+		return sampleAny(f)
+	}
+
+	ident := f.QualifiedGoIdent(message.GoIdent)
+	if visited[message.Desc.FullName()] || len(message.Fields) == 0 {
+		return "&" + ident + "{}"
+	}
+	visited[message.Desc.FullName()] = true
+	defer delete(visited, message.Desc.FullName())
+
+	var b strings.Builder
+	b.WriteString("&" + ident + "{\n")
+	for _, field := range message.Fields {
+		oneof := field.Oneof
+		switch {
+		case oneof != nil && !oneof.Desc.IsSynthetic():
+			if oneof.Fields[0] != field {
+				continue
+			}
+			b.WriteString(oneof.GoName + ": &" + f.QualifiedGoIdent(field.GoIdent) + "{" + field.GoName + ": " + sampleValue(f, field, visited) + "},\n")
+		default:
+			b.WriteString(field.GoName + ": " + sampleField(f, field, visited) + ",\n")
+		}
+	}
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// sampleField renders a sample value for a field, accounting for its cardinality & presence.
+func sampleField(f *protogen.GeneratedFile, field *protogen.Field, visited map[protoreflect.FullName]bool) string {
+	switch {
+	case field.Desc.IsMap():
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		return "map[" + goType(f, key) + "]" + goType(f, value) + "{" + sampleValue(f, key, visited) + ": " + sampleValue(f, value, visited) + "}"
+	case field.Desc.IsList():
+		return "[]" + goType(f, field) + "{" + sampleValue(f, field, visited) + "}"
+	case field.Desc.HasPresence() && field.Message == nil && field.Desc.Kind() != protoreflect.BytesKind:
+		return samplePointer(f, field)
+	}
+
+	return sampleValue(f, field, visited)
+}
+
+// sampleValue renders a single sample value of the field's type.
+func sampleValue(f *protogen.GeneratedFile, field *protogen.Field, visited map[protoreflect.FullName]bool) string {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "true"
+	case protoreflect.EnumKind:
+		return f.QualifiedGoIdent(sampleEnumValue(field.Enum).GoIdent)
+	case protoreflect.StringKind:
+		return strconv.Quote(string(field.Desc.Name()))
+	case protoreflect.BytesKind:
+		return "[]byte(" + strconv.Quote(string(field.Desc.Name())) + ")"
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "1.5"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return sampleMessage(f, field.Message, visited)
+	default:
+		// all remaining kinds are integers.
+		return "1"
+	}
+}
+
+// samplePointer renders a sample value for a scalar field with explicit presence e.g proto3 optional.
+func samplePointer(f *protogen.GeneratedFile, field *protogen.Field) string {
+	if field.Desc.Kind() == protoreflect.EnumKind {
+		return f.QualifiedGoIdent(sampleEnumValue(field.Enum).GoIdent) + ".Enum()"
+	}
+
+	helper := ""
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		helper = "Bool"
+	case protoreflect.StringKind:
+		helper = "String"
+	case protoreflect.FloatKind:
+		helper = "Float32"
+	case protoreflect.DoubleKind:
+		helper = "Float64"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		helper = "Int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		helper = "Uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		helper = "Int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		helper = "Uint64"
+	}
+
+	return f.QualifiedGoIdent(protoPackage.Ident(helper)) + "(" + sampleValue(f, field, nil) + ")"
+}
+
+// sampleAny renders an anypb.Any wrapping a sample string value.
+func sampleAny(f *protogen.GeneratedFile) string {
+	return "func() *" + f.QualifiedGoIdent(anypbPackage.Ident("Any")) + " {\n" +
+		"a, _ := " + f.QualifiedGoIdent(anypbPackage.Ident("New")) + "(" + f.QualifiedGoIdent(wrapperspbPackage.Ident("String")) + "(\"any\"))\n" +
+		"return a\n" +
+		"}()"
+}
+
+// sampleEnumValue returns the first non zero value of the enum when available.
+func sampleEnumValue(enum *protogen.Enum) *protogen.EnumValue {
+	for _, value := range enum.Values {
+		if value.Desc.Number() != 0 {
+			return value
+		}
+	}
+	return enum.Values[0]
+}
+
+// goType returns the go type of a single value of the field e.g *foo.Bar for messages.
+func goType(f *protogen.GeneratedFile, field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.EnumKind:
+		return f.QualifiedGoIdent(field.Enum.GoIdent)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	default:
+		return "*" + f.QualifiedGoIdent(field.Message.GoIdent)
+	}
+}
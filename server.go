@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Server data regarding the main package used to serve services.
+//
+// the top level fields describe the first service being served.
+type Server struct {
+	// ServiceName the name of the service being served.
+	ServiceName string
+	// ServerFullName full service name e.g foo.bar.service.
+	ServerFullName string
+	// Ident the file pkg name.
+	Ident string
+	// ConnectGoImportPath generated connect import path.
+	ConnectGoImportPath string
+	// ConnectIdent the generated connect pkg name.
+	ConnectIdent string
+	// ServiceIdent the generated service struct qualified by its package e.g foo.Service.
+	ServiceIdent string
+	// ServicePkg the package alias of the generated services e.g foo.
+	ServicePkg string
+	// Validate whether requests are validated by the generated protovalidate interceptors.
+	Validate bool
+	// Service the data used to generate the service struct.
+	Service Service
+	// Services every service being served, more than one when services share a package.
+	Services []Server
+}
+
+// newServer creates the Server data for the services, identifiers are qualified relative to the generated file.
+//
+// servicePkg is the import path of the package the services have been generated in.
+func newServer(f *protogen.GeneratedFile, servicePkg protogen.GoImportPath, services []Service) Server {
+	servers := make([]Server, 0, len(services))
+	for _, s := range services {
+		servers = append(servers, Server{
+			ServiceName:         s.ServiceName,
+			ServerFullName:      s.ServerFullName,
+			Ident:               packageAlias(f.QualifiedGoIdent(s.File.GoDescriptorIdent)),
+			ConnectGoImportPath: s.ConnectGoImportPath,
+			ConnectIdent:        packageAlias(f.QualifiedGoIdent(connectPath(s.File).Ident("New" + s.ServiceName + "Handler"))),
+			ServiceIdent:        f.QualifiedGoIdent(servicePkg.Ident(s.StructName)),
+			ServicePkg:          packageAlias(f.QualifiedGoIdent(servicePkg.Ident(s.StructName))),
+			Service:             s,
+		})
+	}
+
+	srv := servers[0]
+	srv.Services = servers
+	return srv
+}
+
+// packageAlias returns the package alias of a qualified identifier e.g foo for foo.Bar.
+func packageAlias(qualifiedIdent string) string {
+	return strings.Split(qualifiedIdent, ".")[0]
+}
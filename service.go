@@ -12,16 +12,30 @@ type Service struct {
 	ConnectGoImportPath string
 	// FileGoPkgName go package for the file.
 	FileGoPkgName string
+	// GoPackageName go package name of the generated code.
+	GoPackageName string
 	// ServiceGoPkg last dir in package.
 	ServiceGoPkg string // todo delete this.
 	// ServiceName
 	ServiceName string
+	// StructName is the name of the struct implementing the service.
+	StructName string
 	// Ident the file pkg name.
 	Ident string
 	// ServerFullName full service name e.g foo.bar.service.
 	ServerFullName string
+	// LeadingComments proto comments preceding the service formatted as go comments.
+	LeadingComments string
+	// TrailingComments proto comments following the service formatted as go comments.
+	TrailingComments string
+	// Deprecated whether the service has been marked as deprecated.
+	Deprecated bool
+	// Values key/values declared via the (boilerplate.service) proto option.
+	Values map[string]string
 	// Methods the methods for the service.
 	Methods []Method
 	// Service the protogen Service.
 	Service *protogen.Service
+	// File the protogen File the service is declared in.
+	File *protogen.File
 }
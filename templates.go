@@ -0,0 +1,140 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	// templatePattern pattern matching every file of a template set.
+	templatePattern = "*.tmpl"
+	// templateExtension extension of templates referred to by name e.g crud_get.
+	templateExtension = ".go.tmpl"
+)
+
+// layeredFS opens files from the first of its file systems containing them.
+type layeredFS []fs.FS
+
+// Open implements fs.FS.
+func (l layeredFS) Open(name string) (fs.File, error) {
+	for _, fsys := range l {
+		f, err := fsys.Open(name)
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+// templateFS returns the file system templates are loaded from.
+//
+// templates are loaded from dir on disk relative to the working directory, falling back per file to the embedded
+// templates within dir or base when dir is not embedded.
+func templateFS(dir string, base string) (layeredFS, error) {
+	var layers layeredFS
+	if info, err := os.Stat(dir); err == nil && info.IsDir() {
+		layers = append(layers, os.DirFS(dir))
+	}
+
+	for _, embeddedDir := range []string{dir, base} {
+		embeddedDir = path.Clean(filepath.ToSlash(embeddedDir))
+		if info, err := fs.Stat(embeddedTemplates, embeddedDir); err != nil || !info.IsDir() {
+			// a directory neither on disk nor embedded is most likely a typo.
+			if len(layers) == 0 {
+				return nil, fmt.Errorf("template directory %q does not exist", embeddedDir)
+			}
+			continue
+		}
+
+		sub, err := fs.Sub(embeddedTemplates, embeddedDir)
+		if err != nil {
+			return nil, err
+		}
+		return append(layers, sub), nil
+	}
+
+	return nil, fmt.Errorf("base template directory %q is not embedded", base)
+}
+
+// parseTemplateSet parses every template within the file systems as a single set, allowing templates to use
+// {{define}}, {{template}} & {{block}} across files.
+//
+// files are parsed from the last file system to the first, so templates & partials defined on disk replace
+// the embedded defaults of the same name.
+func parseTemplateSet(layers layeredFS) (*template.Template, error) {
+	set := template.New("").Funcs(funcMap(nil))
+	for i := len(layers) - 1; i >= 0; i-- {
+		matches, err := fs.Glob(layers[i], templatePattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			continue
+		}
+
+		if _, err := set.ParseFS(layers[i], templatePattern); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// templateCache the parsed template set & every template loaded from it, keyed by kind & override file.
+type templateCache struct {
+	set    *template.Template
+	loaded map[string]*template.Template
+}
+
+func newTemplateCache(set *template.Template) *templateCache {
+	return &templateCache{set: set, loaded: map[string]*template.Template{}}
+}
+
+// load returns the template for the suffix from the template set.
+//
+// an override naming a template e.g crud_get is looked up within the set as crud_get.go.tmpl, so it is resolved
+// from the template directory on disk or the embedded templates. any other override file is parsed into a copy of
+// the set, allowing it to use the partials of the set.
+func (c *templateCache) load(suffix string, override string) (*template.Template, error) {
+	key := suffix + ":" + override
+	if t, ok := c.loaded[key]; ok {
+		return t, nil
+	}
+
+	t := c.set.Lookup(suffix)
+	switch {
+	case isTemplateName(override):
+		suffix = override + templateExtension
+		t = c.set.Lookup(suffix)
+	case override != "":
+		set, err := c.set.Clone()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := set.ParseFiles(override); err != nil {
+			return nil, err
+		}
+		t = set.Lookup(filepath.Base(override))
+	}
+
+	if t == nil {
+		return nil, fmt.Errorf("template %s not found", suffix)
+	}
+
+	c.loaded[key] = t
+	return t, nil
+}
+
+// isTemplateName reports whether an override refers to a template by name e.g crud_get rather than to a file.
+func isTemplateName(override string) bool {
+	return override != "" && !strings.ContainsRune(override, '/') && filepath.Ext(override) == ""
+}
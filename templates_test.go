@@ -0,0 +1,102 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTemplateCacheLoad(t *testing.T) {
+	dir := tempDir(t)
+	if err := os.WriteFile(filepath.Join(dir, "crud_get.go.tmpl"), []byte("// crud_get from disk\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	override := filepath.Join(dir, "override.tpl")
+	if err := os.WriteFile(override, []byte("// override file\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	layers, err := templateFS(dir, defaultDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := parseTemplateSet(layers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	templates := newTemplateCache(set)
+
+	tests := map[string]struct {
+		override string
+		want     string
+		err      string
+	}{
+		"suffix":        {want: unaryMethodSuffix},
+		"disk name":     {override: "crud_get", want: "crud_get.go.tmpl"},
+		"embedded name": {override: "service", want: serviceSuffix},
+		"file":          {override: override, want: "override.tpl"},
+		"missing name":  {override: "crud_list", err: "template crud_list.go.tmpl not found"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := templates.load(unaryMethodSuffix, tt.override)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("got error %v, want %s", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Name() != tt.want {
+				t.Fatalf("got template %s, want %s", got.Name(), tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionTemplates(t *testing.T) {
+	// templates named by proto options are resolved from the template directory, falling back to the embedded templates.
+	dir := tempDir(t)
+	crudGet := "// {{.MethodName}} rendered from crud_get.\nfunc (s *{{.StructName}}) {{.MethodName}}() {}\n"
+	if err := os.WriteFile(filepath.Join(dir, "crud_get.go.tmpl"), []byte(crudGet), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	generated := generateFiles(t, compileRequest(t, "testdata/options", "templateDirectory="+dir))
+	tests := map[string]string{
+		"crudapi/getthing.go": "// GetThing rendered from crud_get.",
+		"crudapi/service.go":  "// Service implements crud.CrudAPI.",
+	}
+	for fileName, want := range tests {
+		if !strings.Contains(generated[fileName], want) {
+			t.Errorf("%s does not contain %q\n%s", fileName, want, generated[fileName])
+		}
+	}
+}
+
+func TestFleshedTemplatesOptions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code using the go command")
+	}
+
+	// the fleshed templates compile without the validation functions & domain structs they would otherwise call.
+	fleshed := "unaryMethodTemplate=method.fleshed.go.tpl,serverStreamMethodTemplate=method.fleshed.server.stream.go.tpl," +
+		"clientStreamMethodTemplate=method.fleshed.client.stream.go.tpl,bidiStreamMethodTemplate=method.fleshed.bidi.stream.go.tpl"
+	tests := map[string]string{"neither": "", "validation": ",validation=true", "domain": ",domain=true"}
+	for name, options := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := tempDir(t)
+			writeFiles(t, dir, generateFiles(t, compileRequest(t, "proto", fleshed+options)))
+
+			out, err := exec.Command("go", "vet", "./"+dir+"/...").CombinedOutput()
+			if err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
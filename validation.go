@@ -0,0 +1,418 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validation data used to generate request validation functions.
+type Validation struct {
+	// Messages the messages to generate validation functions for.
+	Messages []*ValidatedMessage
+}
+
+// ValidatedMessage a message along with the constraints of its fields.
+type ValidatedMessage struct {
+	Message *protogen.Message
+	// Request whether the message is the input of a method, only requests are validated directly.
+	Request bool
+	// Rules constraints of the fields of the message in the order they are declared.
+	Rules []Rule
+	// Nested fields whose messages are validated when they are set.
+	Nested []NestedField
+}
+
+// Rule a constraint of a single field.
+type Rule struct {
+	Field *protogen.Field
+	// Violated go expression reporting whether the message `in` violates the constraint.
+	Violated string
+	// Description of the constraint reported within the field violation.
+	Description string
+	// PatternVar name of the package level regexp of pattern constraints.
+	PatternVar string
+	// Pattern regular expression the field must match.
+	Pattern string
+}
+
+// NestedField a message field whose own constraints are validated.
+type NestedField struct {
+	Field *protogen.Field
+	// Message the validated message of the field, the value of map fields.
+	Message *protogen.Message
+}
+
+// Patterns returns the pattern constraints of the message.
+func (m *ValidatedMessage) Patterns() []Rule {
+	var patterns []Rule
+	for _, rule := range m.Rules {
+		if rule.Pattern != "" {
+			patterns = append(patterns, rule)
+		}
+	}
+	return patterns
+}
+
+// validationMessages appends the input messages of the methods to messages, along with every message reachable via
+// their fields which is declared within the go package importPath. messages which have previously been appended are skipped.
+//
+// constraints are read from buf.validate field constraints & google.api.field_behavior REQUIRED annotations.
+// an error is returned when the functions or patterns of two messages would share a name.
+func validationMessages(messages []*ValidatedMessage, methods []Method, importPath protogen.GoImportPath) ([]*ValidatedMessage, error) {
+	validated := map[protoreflect.FullName]*ValidatedMessage{}
+	for _, message := range messages {
+		validated[message.Message.Desc.FullName()] = message
+	}
+
+	var visit func(message *protogen.Message)
+	visit = func(message *protogen.Message) {
+		if _, ok := validated[message.Desc.FullName()]; ok {
+			return
+		}
+
+		v := &ValidatedMessage{Message: message}
+		validated[message.Desc.FullName()] = v
+		messages = append(messages, v)
+		warnUnsupportedMessageRules(message)
+
+		for _, field := range message.Fields {
+			v.Rules = append(v.Rules, fieldRules(message, field)...)
+
+			nested := field.Message
+			if field.Desc.IsMap() {
+				nested = field.Message.Fields[1].Message
+			}
+			if nested == nil || nested.GoIdent.GoImportPath != importPath {
+				continue
+			}
+			visit(nested)
+			v.Nested = append(v.Nested, NestedField{Field: field, Message: nested})
+		}
+	}
+
+	for _, method := range methods {
+		visit(method.Method.Input)
+		validated[method.Method.Input.Desc.FullName()].Request = true
+	}
+
+	// messages without any constraints, either of their own or of their nested messages, are only validated when they are requests.
+	constrained := map[protoreflect.FullName]bool{}
+	for _, message := range messages {
+		constrained[message.Message.Desc.FullName()] = len(message.Rules) > 0
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, message := range messages {
+			name := message.Message.Desc.FullName()
+			for _, nested := range message.Nested {
+				if !constrained[name] && constrained[nested.Message.Desc.FullName()] {
+					constrained[name] = true
+					changed = true
+				}
+			}
+		}
+	}
+	kept := messages[:0]
+	for _, message := range messages {
+		if !message.Request && !constrained[message.Message.Desc.FullName()] {
+			continue
+		}
+		nested := message.Nested[:0]
+		for _, field := range message.Nested {
+			if constrained[field.Message.Desc.FullName()] {
+				nested = append(nested, field)
+			}
+		}
+		message.Nested = nested
+		kept = append(kept, message)
+	}
+
+	return kept, checkValidationNames(kept)
+}
+
+// checkValidationNames returns an error when the functions or patterns of two messages share a name. functions are
+// named after the go name of the message e.g validateEmpty, regardless of its go package, & patterns after the go names
+// of the message & field e.g patternBookSlug.
+func checkValidationNames(messages []*ValidatedMessage) error {
+	names := map[string]protoreflect.FullName{}
+	for _, message := range messages {
+		name := "validate" + message.Message.GoIdent.GoName
+		if other, ok := names[name]; ok {
+			return fmt.Errorf("validation: %s & %s would both be validated by %s, messages validated within a package must have distinct go names", other, message.Message.Desc.FullName(), name)
+		}
+		names[name] = message.Message.Desc.FullName()
+
+		for _, rule := range message.Patterns() {
+			if other, ok := names[rule.PatternVar]; ok {
+				return fmt.Errorf("validation: the patterns of %s & %s would both be named %s", other, rule.Field.Desc.FullName(), rule.PatternVar)
+			}
+			names[rule.PatternVar] = rule.Field.Desc.FullName()
+		}
+	}
+	return nil
+}
+
+// fieldRules returns the constraints of a field of the message.
+func fieldRules(message *protogen.Message, field *protogen.Field) []Rule {
+	getter := "in.Get" + field.GoName + "()"
+
+	var rules []Rule
+	if isRequired(field) {
+		rules = append(rules, Rule{Field: field, Violated: unsetCondition(field, getter), Description: "value is required"})
+	}
+
+	constraints, _ := proto.GetExtension(field.Desc.Options(), validate.E_Field).(*validate.FieldConstraints)
+	if constraints == nil {
+		return rules
+	}
+
+	// the rules message of the set constraint type e.g buf.validate.StringRules.
+	typeRules := constraints.ProtoReflect().WhichOneof(constraints.ProtoReflect().Descriptor().Oneofs().ByName("type"))
+	// only required is supported for the members of a oneof.
+	if typeRules == nil || typeRules.Kind() != protoreflect.MessageKind || (field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) {
+		warnUnsupportedRules(field, constraints, nil)
+		return rules
+	}
+	warnUnsupportedRules(field, constraints, supportedRules[typeRules.Name()])
+	ruleSet := constraints.ProtoReflect().Get(typeRules).Message()
+
+	// constraints of fields with presence are only checked when the field is set.
+	guard := ""
+	if field.Desc.HasPresence() && field.Message == nil {
+		guard = "in." + field.GoName + " != nil && "
+	}
+
+	add := func(name protoreflect.Name, condition string, description string) {
+		fd := ruleSet.Descriptor().Fields().ByName(name)
+		if fd == nil || !ruleSet.Has(fd) {
+			return
+		}
+		value := ruleSet.Get(fd).Interface()
+		rules = append(rules, Rule{
+			Field:       field,
+			Violated:    guard + fmt.Sprintf(condition, value),
+			Description: fmt.Sprintf(description, value),
+		})
+	}
+
+	switch typeRules.Name() {
+	case "string":
+		add("min_len", "utf8.RuneCountInString("+getter+") < %d", "value length must be at least %d characters")
+		add("max_len", "utf8.RuneCountInString("+getter+") > %d", "value length must be at most %d characters")
+		if pattern := constraints.GetString_().Pattern; pattern != nil {
+			patternVar := "pattern" + message.GoIdent.GoName + field.GoName
+			rules = append(rules, Rule{
+				Field:       field,
+				Violated:    guard + "!" + patternVar + ".MatchString(" + getter + ")",
+				Description: fmt.Sprintf("value does not match regex pattern %q", *pattern),
+				PatternVar:  patternVar,
+				Pattern:     *pattern,
+			})
+		}
+	case "bytes":
+		add("min_len", "len("+getter+") < %d", "value length must be at least %d bytes")
+		add("max_len", "len("+getter+") > %d", "value length must be at most %d bytes")
+	case "repeated":
+		add("min_items", "len("+getter+") < %d", "value must contain at least %d item(s)")
+		add("max_items", "len("+getter+") > %d", "value must contain no more than %d item(s)")
+	case "map":
+		add("min_pairs", "len("+getter+") < %d", "map must be at least %d entries")
+		add("max_pairs", "len("+getter+") > %d", "map must be at most %d entries")
+	case "int32", "int64", "uint32", "uint64", "sint32", "sint64", "fixed32", "fixed64", "sfixed32", "sfixed64", "float", "double":
+		if violated, description, ok := rangeRule(ruleSet, getter, field.Desc.Kind() == protoreflect.FloatKind || field.Desc.Kind() == protoreflect.DoubleKind); ok {
+			if guard != "" {
+				violated = parenthesize(violated)
+			}
+			rules = append(rules, Rule{Field: field, Violated: guard + violated, Description: description})
+		}
+	}
+
+	return rules
+}
+
+// numericRules the rules of numbers which are checked.
+var numericRules = []protoreflect.Name{"gt", "gte", "lt", "lte"}
+
+// supportedRules the rules of each constraint type which are checked, keyed by the constraint type e.g string.
+var supportedRules = map[protoreflect.Name][]protoreflect.Name{
+	"string":   {"min_len", "max_len", "pattern"},
+	"bytes":    {"min_len", "max_len"},
+	"repeated": {"min_items", "max_items"},
+	"map":      {"min_pairs", "max_pairs"},
+	"int32":    numericRules,
+	"int64":    numericRules,
+	"uint32":   numericRules,
+	"uint64":   numericRules,
+	"sint32":   numericRules,
+	"sint64":   numericRules,
+	"fixed32":  numericRules,
+	"fixed64":  numericRules,
+	"sfixed32": numericRules,
+	"sfixed64": numericRules,
+	"float":    numericRules,
+	"double":   numericRules,
+}
+
+// warnUnsupportedRules warns of the buf.validate constraints of the field which are not checked, other than required
+// & the supported rules of its constraint type, so they are not mistaken as being enforced.
+func warnUnsupportedRules(field *protogen.Field, constraints *validate.FieldConstraints, supported []protoreflect.Name) {
+	constraints.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.Name() == "required":
+		case fd.ContainingOneof() != nil && fd.ContainingOneof().Name() == "type" && fd.Kind() == protoreflect.MessageKind:
+			value.Message().Range(func(rule protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+				if !slices.Contains(supported, rule.Name()) {
+					warnf("%s: buf.validate.field %s.%s is not supported & will not be validated", field.Desc.FullName(), fd.Name(), rule.Name())
+				}
+				return true
+			})
+		default:
+			warnf("%s: buf.validate.field %s is not supported & will not be validated", field.Desc.FullName(), fd.Name())
+		}
+		return true
+	})
+}
+
+// warnUnsupportedMessageRules warns of the buf.validate constraints of the message & its oneofs, none of which are checked.
+func warnUnsupportedMessageRules(message *protogen.Message) {
+	if constraints, _ := proto.GetExtension(message.Desc.Options(), validate.E_Message).(*validate.MessageConstraints); constraints != nil {
+		constraints.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			warnf("%s: buf.validate.message %s is not supported & will not be validated", message.Desc.FullName(), fd.Name())
+			return true
+		})
+	}
+
+	for _, oneof := range message.Oneofs {
+		if constraints, _ := proto.GetExtension(oneof.Desc.Options(), validate.E_Oneof).(*validate.OneofConstraints); constraints != nil {
+			constraints.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+				warnf("%s: buf.validate.oneof %s is not supported & will not be validated", oneof.Desc.FullName(), fd.Name())
+				return true
+			})
+		}
+	}
+}
+
+// numericBounds the bounds of the numeric rules e.g buf.validate.Int32Rules, along with the go operator reporting
+// whether a value violates the bound.
+var numericBounds = []struct {
+	name        protoreflect.Name
+	lower       bool
+	operator    string
+	description string
+}{
+	{name: "gt", lower: true, operator: "<=", description: "greater than %v"},
+	{name: "gte", lower: true, operator: "<", description: "greater than or equal to %v"},
+	{name: "lt", operator: ">=", description: "less than %v"},
+	{name: "lte", operator: ">", description: "less than or equal to %v"},
+}
+
+// rangeRule returns a single go expression reporting whether the value returned by getter violates the lower & upper
+// bounds of the numeric rules, along with the description of the constraint.
+//
+// as with protovalidate an upper bound less than the lower bound is an exclusive range e.g gt: 10, lt: 5 only accepts
+// values less than 5 or greater than 10, & NaN violates the bounds of floats.
+func rangeRule(ruleSet protoreflect.Message, getter string, float bool) (string, string, bool) {
+	var lower, upper protoreflect.Value
+	var lowerViolated, upperViolated, lowerDescription, upperDescription string
+	for _, bound := range numericBounds {
+		fd := ruleSet.Descriptor().Fields().ByName(bound.name)
+		if fd == nil || !ruleSet.Has(fd) {
+			continue
+		}
+		value := ruleSet.Get(fd)
+		violated := fmt.Sprintf("%s %s %v", getter, bound.operator, value.Interface())
+		description := fmt.Sprintf(bound.description, value.Interface())
+		if bound.lower {
+			lower, lowerViolated, lowerDescription = value, violated, description
+		} else {
+			upper, upperViolated, upperDescription = value, violated, description
+		}
+	}
+
+	var violated, description string
+	switch {
+	case lowerViolated == "" && upperViolated == "":
+		return "", "", false
+	case upperViolated == "":
+		violated, description = lowerViolated, "value must be "+lowerDescription
+	case lowerViolated == "":
+		violated, description = upperViolated, "value must be "+upperDescription
+	case compareBounds(upper.Interface(), lower.Interface()) >= 0:
+		violated, description = lowerViolated+" || "+upperViolated, "value must be "+lowerDescription+" and "+upperDescription
+	default:
+		violated, description = lowerViolated+" && "+upperViolated, "value must be "+lowerDescription+" or "+upperDescription
+	}
+
+	if float {
+		violated = "math.IsNaN(float64(" + getter + ")) || " + parenthesize(violated)
+	}
+	return violated, description, true
+}
+
+// compareBounds compares two bounds of the same numeric rules, returning -1 when a is less than b, 0 when they are
+// equal & +1 when a is greater than b.
+func compareBounds(a, b any) int {
+	switch a := a.(type) {
+	case int32:
+		return cmp.Compare(a, b.(int32))
+	case int64:
+		return cmp.Compare(a, b.(int64))
+	case uint32:
+		return cmp.Compare(a, b.(uint32))
+	case uint64:
+		return cmp.Compare(a, b.(uint64))
+	case float32:
+		return cmp.Compare(a, b.(float32))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	}
+	return 0
+}
+
+// parenthesize wraps a go expression combining conditions in parentheses.
+func parenthesize(expr string) string {
+	if strings.Contains(expr, "||") || strings.Contains(expr, "&&") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// isRequired reports whether the field is annotated as required by either buf.validate or google.api.field_behavior.
+func isRequired(field *protogen.Field) bool {
+	if constraints, _ := proto.GetExtension(field.Desc.Options(), validate.E_Field).(*validate.FieldConstraints); constraints.GetRequired() {
+		return true
+	}
+
+	behaviors, _ := proto.GetExtension(field.Desc.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	for _, behavior := range behaviors {
+		if behavior == annotations.FieldBehavior_REQUIRED {
+			return true
+		}
+	}
+	return false
+}
+
+// unsetCondition returns a go expression reporting whether the field of the message `in` is unset.
+func unsetCondition(field *protogen.Field, getter string) string {
+	switch {
+	case field.Desc.IsList() || field.Desc.IsMap() || field.Desc.Kind() == protoreflect.BytesKind:
+		return "len(" + getter + ") == 0"
+	case field.Message != nil:
+		return getter + " == nil"
+	case field.Desc.HasPresence() && (field.Oneof == nil || field.Oneof.Desc.IsSynthetic()):
+		return "in." + field.GoName + " == nil"
+	case field.Desc.Kind() == protoreflect.BoolKind:
+		return "!" + getter
+	case field.Desc.Kind() == protoreflect.StringKind:
+		return getter + ` == ""`
+	}
+	return getter + " == 0"
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// rangesTest compares the generated validation of ranges.proto with protovalidate, at & around the bounds of every field.
+const rangesTest = `package rangespb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/protobuf/proto"
+
+	pb %q
+)
+
+func TestRanges(t *testing.T) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cases []func(m *pb.Ranges)
+	for _, v := range []int32{-1, 0, 1, 4, 5, 6, 9, 10, 11} {
+		cases = append(cases,
+			func(m *pb.Ranges) { m.Inclusive = v },
+			func(m *pb.Ranges) { m.Exclusive = v },
+			func(m *pb.Ranges) { m.Equal = v },
+			func(m *pb.Ranges) { m.OptionalExclusive = proto.Int32(v) },
+		)
+	}
+	for _, v := range []uint32{0, 4, 5, 6} {
+		cases = append(cases, func(m *pb.Ranges) { m.Lower = v })
+	}
+	for _, v := range []int64{-1, 4, 5, 6} {
+		cases = append(cases, func(m *pb.Ranges) { m.Upper = v })
+	}
+	for _, v := range []float64{math.NaN(), -1, 0, 0.5, 1, 1.5} {
+		cases = append(cases,
+			func(m *pb.Ranges) { m.Ratio = v },
+			func(m *pb.Ranges) { m.ExclusiveFloat = float32(v) },
+		)
+	}
+
+	for _, set := range cases {
+		m := &pb.Ranges{Inclusive: 5, Exclusive: 11, Equal: 5, Lower: 5, Upper: 5, Ratio: 0.5, ExclusiveFloat: 2}
+		if violations := fieldViolationsRanges(m, ""); len(violations) > 0 {
+			t.Fatalf("the valid message violates %%v", violations)
+		}
+
+		set(m)
+		violations := fieldViolationsRanges(m, "")
+		err := validator.Validate(m)
+		if (len(violations) > 0) != (err != nil) {
+			t.Errorf("%%v: generated violations %%v, protovalidate %%v", m, violations, err)
+		}
+	}
+}
+`
+
+func TestValidationRanges(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs the generated code using the go command")
+	}
+
+	for _, templateDirectory := range []string{"templates", "templates/connect"} {
+		t.Run(templateDirectory, func(t *testing.T) {
+			dir := tempDir(t)
+			req := compileRequest(t, "testdata/validation", "validation=true,templateDirectory="+templateDirectory, "ranges/ranges.proto")
+			writeFiles(t, dir, goBindings(t, dir, req))
+
+			// only the validation functions are compiled, as the service requires the bindings of protoc-gen-go-grpc or connect.
+			validation, ok := generateFiles(t, req)["rangesapi/zz_generated_validation.go"]
+			if !ok {
+				t.Fatal("rangesapi/zz_generated_validation.go was not generated")
+			}
+			writeFiles(t, dir, map[string]string{
+				"rangesapi/zz_generated_validation.go": validation,
+				"rangesapi/ranges_test.go":             fmt.Sprintf(rangesTest, path.Join(modulePath, dir, "rangespb")),
+			})
+
+			out, err := exec.Command("go", "test", "./"+dir+"/rangesapi").CombinedOutput()
+			if err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
+
+func TestValidationUnsupportedRules(t *testing.T) {
+	var warned bytes.Buffer
+	warnings = &warned
+	t.Cleanup(func() { warnings = os.Stderr })
+
+	generated := generateFiles(t, compileRequest(t, "testdata/validation", "validation=true", "unsupported/unsupported.proto"))
+	validation := generated["unsupportedapi/zz_generated_validation.go"]
+	for _, want := range []string{`"value length must be at least 1 characters"`, `Field: prefix + "number", Description: "value is required"`} {
+		if !strings.Contains(validation, want) {
+			t.Errorf("unsupportedapi/zz_generated_validation.go does not contain %q\n%s", want, validation)
+		}
+	}
+
+	want := []string{
+		"unsupported.Unsupported: buf.validate.message cel is not supported & will not be validated",
+		"unsupported.Unsupported.choice: buf.validate.oneof required is not supported & will not be validated",
+		"unsupported.Unsupported.name: buf.validate.field string.const is not supported & will not be validated",
+		"unsupported.Unsupported.name: buf.validate.field string.in is not supported & will not be validated",
+		"unsupported.Unsupported.email: buf.validate.field string.email is not supported & will not be validated",
+		"unsupported.Unsupported.kind: buf.validate.field enum.defined_only is not supported & will not be validated",
+		"unsupported.Unsupported.tags: buf.validate.field repeated.unique is not supported & will not be validated",
+		"unsupported.Unsupported.count: buf.validate.field cel is not supported & will not be validated",
+		"unsupported.Unsupported.id: buf.validate.field string.uuid is not supported & will not be validated",
+	}
+	var got []string
+	for _, line := range strings.Split(strings.TrimSpace(warned.String()), "\n") {
+		got = append(got, strings.TrimPrefix(line, "protoc-gen-go-boilerplate: warning: "))
+	}
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("got warnings\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestValidationNameCollisions(t *testing.T) {
+	tests := map[string]string{
+		"names/names.proto":       "validation: names.Empty & other.Empty would both be validated by validateEmpty",
+		"patterns/patterns.proto": "validation: the patterns of patterns.AB.c & patterns.A.b_c would both be named patternABC",
+	}
+	for file, want := range tests {
+		opts, f := plugin()
+		resp, err := generate(opts, f, compileRequest(t, "testdata/validation", "validation=true", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(resp.GetError(), want) {
+			t.Errorf("%s: got error %q, want %q", file, resp.GetError(), want)
+		}
+	}
+}